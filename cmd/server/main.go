@@ -15,6 +15,7 @@ import (
 	"lucid-lists-backend/internal/config"
 	"lucid-lists-backend/internal/database"
 	"lucid-lists-backend/internal/handlers"
+	"lucid-lists-backend/internal/jobs"
 	"lucid-lists-backend/internal/middleware"
 	"lucid-lists-backend/internal/repositories"
 	"lucid-lists-backend/internal/routes"
@@ -49,22 +50,38 @@ func main() {
 	projectRepo := repositories.NewProjectRepository(db)
 	listRepo := repositories.NewListRepository(db)
 	taskRepo := repositories.NewTaskRepository(db)
+	snapshotRepo := repositories.NewProgressSnapshotRepository(db)
+	taskHistoryRepo := repositories.NewTaskHistoryRepository(db)
+	templateRepo := repositories.NewTemplateRepository(db)
+	checklistRepo := repositories.NewChecklistRepository(db)
+	taskDefaultsRepo := repositories.NewTaskDefaultsRepository(db)
+	dependencyRepo := repositories.NewDependencyRepository(db)
+	linkRepo := repositories.NewLinkRepository(db)
+	projectStateRepo := repositories.NewProjectStateRepository(db)
 
 	// Initialize services
-	projectService := services.NewProjectService(projectRepo, listRepo, taskRepo)
+	projectService := services.NewProjectService(projectRepo, listRepo, taskRepo, snapshotRepo, templateRepo, taskDefaultsRepo, projectStateRepo, taskHistoryRepo)
 	listService := services.NewListService(listRepo, taskRepo, projectRepo)
-	taskService := services.NewTaskService(taskRepo, listRepo)
+	taskService := services.NewTaskService(taskRepo, listRepo, taskHistoryRepo, checklistRepo, taskDefaultsRepo, dependencyRepo, linkRepo)
+	templateService := services.NewTemplateService(templateRepo)
 
 	// Initialize handlers
 	projectHandler := handlers.NewProjectHandler(projectService)
 	listHandler := handlers.NewListHandler(listService)
 	taskHandler := handlers.NewTaskHandler(taskService)
+	templateHandler := handlers.NewTemplateHandler(templateService)
+	metaHandler := handlers.NewMetaHandler(cfg)
 
 	// Setup router
 	router := setupRouter(cfg)
 
 	// Setup routes
-	routes.SetupRoutes(router, projectHandler, listHandler, taskHandler)
+	routes.SetupRoutes(router, projectHandler, listHandler, taskHandler, templateHandler, metaHandler)
+
+	// Start background jobs
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	jobs.NewProgressSnapshotJob(snapshotRepo).Start(jobsCtx, 24*time.Hour)
 
 	// Create server
 	server := &http.Server{
@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"strconv"
 	"strings"
 )
 
@@ -24,6 +25,11 @@ type Config struct {
 
 	// CORS
 	CORSAllowedOrigins []string
+
+	// Features, reported by GET /api/meta
+	FeatureAI       bool
+	FeatureWebhooks bool
+	FeatureRealtime bool
 }
 
 func Load() *Config {
@@ -46,6 +52,11 @@ func Load() *Config {
 
 		// CORS
 		CORSAllowedOrigins: getCORSOrigins(),
+
+		// Features
+		FeatureAI:       getEnvBool("FEATURE_AI", false),
+		FeatureWebhooks: getEnvBool("FEATURE_WEBHOOKS", false),
+		FeatureRealtime: getEnvBool("FEATURE_REALTIME", false),
 	}
 }
 
@@ -56,6 +67,15 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvBool reads a boolean such as "true" or "1", falling back to defaultValue when unset or invalid
+func getEnvBool(key string, defaultValue bool) bool {
+	value, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
 func getCORSOrigins() []string {
 	origins := getEnv("CORS_ALLOWED_ORIGINS", "http://localhost:5173,http://localhost:3000,http://localhost:8080,http://localhost:8082,http://localhost:8081")
 	frontendPort := getEnv("FRONTEND_PORT", "")
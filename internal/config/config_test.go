@@ -0,0 +1,21 @@
+package config
+
+import "testing"
+
+func TestLoadFeatureFlags(t *testing.T) {
+	t.Setenv("FEATURE_AI", "true")
+	t.Setenv("FEATURE_WEBHOOKS", "not-a-bool")
+	t.Setenv("FEATURE_REALTIME", "")
+
+	cfg := Load()
+
+	if !cfg.FeatureAI {
+		t.Error("expected FEATURE_AI=true to enable the AI feature")
+	}
+	if cfg.FeatureWebhooks {
+		t.Error("expected an invalid FEATURE_WEBHOOKS to fall back to disabled")
+	}
+	if cfg.FeatureRealtime {
+		t.Error("expected an unset FEATURE_REALTIME to be disabled")
+	}
+}
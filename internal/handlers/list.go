@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"strconv"
+
 	"lucid-lists-backend/internal/models"
 	"lucid-lists-backend/internal/services"
 	"lucid-lists-backend/internal/utils"
@@ -43,6 +45,67 @@ func (h *ListHandler) CreateList(c *gin.Context) {
 	utils.CreatedResponse(c, list, "List created successfully")
 }
 
+// CreateListsBulk handles POST /api/projects/:uid/lists/bulk
+func (h *ListHandler) CreateListsBulk(c *gin.Context) {
+	uidStr := c.Param("uid")
+	projectUID, err := uuid.Parse(uidStr)
+	if err != nil {
+		utils.SendValidationError(c, "Invalid project ID format")
+		return
+	}
+
+	var req models.BulkCreateListsRequest
+	if err := utils.BindAndValidate(c, &req); err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	lists, err := h.listService.CreateListsBulk(c.Request.Context(), projectUID, &req)
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"project_uid": projectUID,
+			"count":       len(req.Lists),
+		}).Error("Failed to bulk create lists")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, lists, "Lists created successfully")
+}
+
+// GetListTrend handles GET /api/projects/:uid/lists/:listUid/trend
+func (h *ListHandler) GetListTrend(c *gin.Context) {
+	projectUID, err := uuid.Parse(c.Param("uid"))
+	if err != nil {
+		utils.SendValidationError(c, "Invalid project ID format")
+		return
+	}
+
+	listUID, err := uuid.Parse(c.Param("listUid"))
+	if err != nil {
+		utils.SendValidationError(c, "Invalid list ID format")
+		return
+	}
+
+	days, err := strconv.Atoi(c.DefaultQuery("days", "30"))
+	if err != nil || days < 1 || days > 365 {
+		utils.SendValidationError(c, "days must be a number between 1 and 365")
+		return
+	}
+
+	trend, err := h.listService.GetListTrend(c.Request.Context(), projectUID, listUID, days)
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"project_uid": projectUID,
+			"list_uid":    listUID,
+		}).Error("Failed to get list trend")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, trend, "")
+}
+
 // UpdateList handles PUT /api/lists/:uid
 func (h *ListHandler) UpdateList(c *gin.Context) {
 	uidStr := c.Param("uid")
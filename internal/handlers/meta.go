@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"time"
+
+	"lucid-lists-backend/internal/config"
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIVersion is reported by GET /api/meta so clients can detect the API they talk to
+const APIVersion = "1"
+
+type MetaHandler struct {
+	features models.MetaFeatures
+	now      func() time.Time
+}
+
+func NewMetaHandler(cfg *config.Config) *MetaHandler {
+	return &MetaHandler{
+		features: models.MetaFeatures{
+			AI:       cfg.FeatureAI,
+			Webhooks: cfg.FeatureWebhooks,
+			Realtime: cfg.FeatureRealtime,
+		},
+		now: time.Now,
+	}
+}
+
+// GetMeta handles GET /api/meta
+func (h *MetaHandler) GetMeta(c *gin.Context) {
+	utils.SuccessResponse(c, models.MetaResponse{
+		APIVersion: APIVersion,
+		Features:   h.features,
+	}, "")
+}
+
+// GetTime handles GET /api/time. It reports the server clock and the same instant in the
+// requested timezone, for debugging date bucketing.
+func (h *MetaHandler) GetTime(c *gin.Context) {
+	loc, err := utils.ParseTimezone(c.Query("timezone"))
+	if err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	now := h.now()
+	utils.SuccessResponse(c, models.ServerTimeResponse{
+		ServerUTC: now.UTC(),
+		UserLocal: now.In(loc),
+		Timezone:  loc.String(),
+	}, "")
+}
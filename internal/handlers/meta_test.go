@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"lucid-lists-backend/internal/config"
+	"lucid-lists-backend/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// serve runs a single request against handler mounted at path and decodes the response data into out
+func serve(t *testing.T, path string, handler gin.HandlerFunc, target string, out interface{}) int {
+	t.Helper()
+
+	router := gin.New()
+	router.GET(path, handler)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+
+	if recorder.Code == http.StatusOK && out != nil {
+		body := struct {
+			Data interface{} `json:"data"`
+		}{Data: out}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+	}
+	return recorder.Code
+}
+
+func TestGetMetaReportsConfiguredFeatures(t *testing.T) {
+	handler := NewMetaHandler(&config.Config{FeatureWebhooks: true})
+
+	var meta models.MetaResponse
+	if code := serve(t, "/api/meta", handler.GetMeta, "/api/meta", &meta); code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+
+	if meta.APIVersion != APIVersion {
+		t.Errorf("expected api_version %q, got %q", APIVersion, meta.APIVersion)
+	}
+	if meta.Features.AI || meta.Features.Realtime {
+		t.Errorf("expected disabled features to report false, got %+v", meta.Features)
+	}
+	if !meta.Features.Webhooks {
+		t.Errorf("expected webhooks to be enabled, got %+v", meta.Features)
+	}
+}
+
+func TestGetMetaDefaultsToNoFeatures(t *testing.T) {
+	handler := NewMetaHandler(&config.Config{})
+
+	var meta models.MetaResponse
+	if code := serve(t, "/api/meta", handler.GetMeta, "/api/meta", &meta); code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+
+	if meta.Features != (models.MetaFeatures{}) {
+		t.Errorf("expected all features disabled, got %+v", meta.Features)
+	}
+}
+
+func TestGetTimeInTimezone(t *testing.T) {
+	handler := NewMetaHandler(&config.Config{})
+	instant := time.Date(2026, 3, 10, 3, 30, 0, 0, time.UTC)
+	handler.now = func() time.Time { return instant }
+
+	var response struct {
+		ServerUTC string `json:"server_utc"`
+		UserLocal string `json:"user_local"`
+		Timezone  string `json:"timezone"`
+	}
+	code := serve(t, "/api/time", handler.GetTime, "/api/time?timezone=America/New_York", &response)
+	if code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+
+	if response.ServerUTC != "2026-03-10T03:30:00Z" {
+		t.Errorf("unexpected server_utc %s", response.ServerUTC)
+	}
+	if response.UserLocal != "2026-03-09T23:30:00-04:00" {
+		t.Errorf("unexpected user_local %s", response.UserLocal)
+	}
+	if response.Timezone != "America/New_York" {
+		t.Errorf("unexpected timezone %s", response.Timezone)
+	}
+}
+
+func TestGetTimeDefaultsToUTC(t *testing.T) {
+	handler := NewMetaHandler(&config.Config{})
+
+	var response models.ServerTimeResponse
+	if code := serve(t, "/api/time", handler.GetTime, "/api/time", &response); code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+
+	if response.Timezone != "UTC" || !response.UserLocal.Equal(response.ServerUTC) {
+		t.Errorf("expected UTC, got %+v", response)
+	}
+}
+
+func TestGetTimeInvalidTimezone(t *testing.T) {
+	handler := NewMetaHandler(&config.Config{})
+
+	if code := serve(t, "/api/time", handler.GetTime, "/api/time?timezone=Mars/Olympus", nil); code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", code)
+	}
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -26,7 +27,12 @@ func NewProjectHandler(projectService *services.ProjectService) *ProjectHandler
 func (h *ProjectHandler) GetProjects(c *gin.Context) {
 	logger.WithComponent("project-handler").Info("Getting all projects")
 
-	projects, err := h.projectService.GetAllProjects(c.Request.Context())
+	opts := services.ProjectListOptions{
+		IncludeArchived: c.Query("include_archived") == "true",
+		IncludeCounts:   c.Query("include_counts") == "true",
+	}
+
+	projects, err := h.projectService.GetAllProjects(c.Request.Context(), opts)
 	if err != nil {
 		logger.WithComponent("project-handler").
 			WithFields(map[string]interface{}{"error": err.Error()}).
@@ -42,6 +48,34 @@ func (h *ProjectHandler) GetProjects(c *gin.Context) {
 	utils.SuccessResponse(c, projects, "")
 }
 
+// GetStatusCounts handles GET /api/projects/status-counts
+func (h *ProjectHandler) GetStatusCounts(c *gin.Context) {
+	counts, err := h.projectService.GetStatusCounts(c.Request.Context())
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"error": err.Error()}).
+			Error("Failed to get project status counts")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, counts, "")
+}
+
+// CheckNameAvailable handles GET /api/projects/name-available
+func (h *ProjectHandler) CheckNameAvailable(c *gin.Context) {
+	result, err := h.projectService.CheckNameAvailable(c.Request.Context(), c.Query("name"))
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"error": err.Error()}).
+			Warn("Failed to check project name availability")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, result, "")
+}
+
 // GetProject handles GET /api/projects/:uid
 func (h *ProjectHandler) GetProject(c *gin.Context) {
 	uidParam := c.Param("uid")
@@ -59,7 +93,9 @@ func (h *ProjectHandler) GetProject(c *gin.Context) {
 		return
 	}
 
-	project, err := h.projectService.GetProjectWithLists(c.Request.Context(), projectUID)
+	includeArchived := c.Query("include_archived") == "true"
+
+	project, err := h.projectService.GetProjectWithLists(c.Request.Context(), projectUID, includeArchived)
 	if err != nil {
 		logger.WithComponent("project-handler").
 			WithFields(map[string]interface{}{
@@ -116,6 +152,111 @@ func (h *ProjectHandler) CreateProject(c *gin.Context) {
 	utils.CreatedResponse(c, project, "Project created successfully")
 }
 
+// CreateFromTemplate handles POST /api/projects/from-template
+func (h *ProjectHandler) CreateFromTemplate(c *gin.Context) {
+	var req models.ProjectFromTemplateRequest
+	if err := utils.BindAndValidate(c, &req); err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	logger.WithComponent("project-handler").
+		WithFields(map[string]interface{}{
+			"template_uid": req.TemplateUID.String(),
+			"project_name": req.Name,
+		}).
+		Info("Creating project from template")
+
+	project, err := h.projectService.CreateFromTemplate(c.Request.Context(), &req)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"template_uid": req.TemplateUID.String(),
+				"error":        err.Error(),
+			}).
+			Error("Failed to create project from template")
+		utils.SendError(c, err)
+		return
+	}
+
+	logger.WithComponent("project-handler").
+		WithFields(map[string]interface{}{
+			"project_uid":  project.ProjectUID.String(),
+			"project_name": project.Name,
+		}).
+		Info("Successfully created project from template")
+
+	utils.CreatedResponse(c, project, "Project created successfully")
+}
+
+// ReorderProjects handles POST /api/projects/reorder
+func (h *ProjectHandler) ReorderProjects(c *gin.Context) {
+	var req models.ProjectReorderRequest
+	if err := utils.BindAndValidate(c, &req); err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	projects, err := h.projectService.ReorderProjects(c.Request.Context(), &req)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"count": len(req.Items),
+				"error": err.Error(),
+			}).
+			Error("Failed to reorder projects")
+		utils.SendError(c, err)
+		return
+	}
+
+	logger.WithComponent("project-handler").
+		WithFields(map[string]interface{}{"count": len(req.Items)}).
+		Info("Successfully reordered projects")
+
+	utils.SuccessResponse(c, projects, "Projects reordered successfully")
+}
+
+// ReorderBoard handles PUT /api/projects/:uid/board/reorder
+func (h *ProjectHandler) ReorderBoard(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	var req models.BoardReorderRequest
+	if err := utils.BindAndValidate(c, &req); err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	project, err := h.projectService.ReorderBoard(c.Request.Context(), projectUID, &req)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to reorder board")
+		utils.SendError(c, err)
+		return
+	}
+
+	logger.WithComponent("project-handler").
+		WithFields(map[string]interface{}{
+			"project_uid": projectUID.String(),
+			"lists":       len(req.Lists),
+		}).
+		Info("Board reordered")
+
+	utils.SuccessResponse(c, project, "Board reordered successfully")
+}
+
 // UpdateProject handles PUT /api/projects/:uid
 func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 	uidParam := c.Param("uid")
@@ -197,6 +338,93 @@ func (h *ProjectHandler) DeleteProject(c *gin.Context) {
 	utils.SuccessResponse(c, nil, "Project deleted successfully")
 }
 
+// RestoreProject handles POST /api/projects/:uid/restore
+func (h *ProjectHandler) RestoreProject(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	logger.WithComponent("project-handler").
+		WithFields(map[string]interface{}{"project_uid": uidParam}).
+		Info("Restoring project")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	project, err := h.projectService.RestoreProject(c.Request.Context(), projectUID)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to restore project")
+		utils.SendError(c, err)
+		return
+	}
+
+	logger.WithComponent("project-handler").
+		WithFields(map[string]interface{}{"project_uid": projectUID.String()}).
+		Info("Successfully restored project")
+
+	utils.SuccessResponse(c, project, "Project restored successfully")
+}
+
+// SetArchived handles PATCH /api/projects/:uid/archive
+func (h *ProjectHandler) SetArchived(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	var req models.ProjectArchiveRequest
+	if err := utils.BindAndValidate(c, &req); err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	var project *models.ProjectResponse
+	if *req.Archived {
+		project, err = h.projectService.ArchiveProject(c.Request.Context(), projectUID)
+	} else {
+		project, err = h.projectService.UnarchiveProject(c.Request.Context(), projectUID)
+	}
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"archived":    *req.Archived,
+				"error":       err.Error(),
+			}).
+			Error("Failed to update project archive state")
+		utils.SendError(c, err)
+		return
+	}
+
+	logger.WithComponent("project-handler").
+		WithFields(map[string]interface{}{
+			"project_uid": projectUID.String(),
+			"archived":    *req.Archived,
+		}).
+		Info("Successfully updated project archive state")
+
+	if *req.Archived {
+		utils.SuccessResponse(c, project, "Project archived successfully")
+	} else {
+		utils.SuccessResponse(c, project, "Project unarchived successfully")
+	}
+}
+
 // PartialUpdateProject handles PATCH /api/projects/:uid
 func (h *ProjectHandler) PartialUpdateProject(c *gin.Context) {
 	uidParam := c.Param("uid")
@@ -248,3 +476,660 @@ func (h *ProjectHandler) PartialUpdateProject(c *gin.Context) {
 
 	utils.SuccessResponse(c, project, "Project updated successfully")
 }
+
+// maxCalendarDays caps the date range a calendar request may cover
+const maxCalendarDays = 92
+
+// GetProgressHistory handles GET /api/projects/:uid/progress/history
+func (h *ProjectHandler) GetProgressHistory(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	days, err := strconv.Atoi(c.DefaultQuery("days", "30"))
+	if err != nil || days < 1 || days > 365 {
+		utils.SendValidationError(c, "days must be a number between 1 and 365")
+		return
+	}
+
+	history, err := h.projectService.GetProgressHistory(c.Request.Context(), projectUID, days)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to get project progress history")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, history, "")
+}
+
+// ArchiveCompletedTasks handles POST /api/projects/:uid/tasks/archive-completed
+func (h *ProjectHandler) ArchiveCompletedTasks(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	result, err := h.projectService.ArchiveCompletedTasks(c.Request.Context(), projectUID)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to archive completed tasks")
+		utils.SendError(c, err)
+		return
+	}
+
+	logger.WithComponent("project-handler").
+		WithFields(map[string]interface{}{
+			"project_uid": projectUID.String(),
+			"archived":    result.Archived,
+		}).
+		Info("Archived completed tasks")
+
+	utils.SuccessResponse(c, result, "Completed tasks archived successfully")
+}
+
+// GetTaskCalendar handles GET /api/projects/:uid/tasks/calendar
+func (h *ProjectHandler) GetTaskCalendar(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	loc, err := utils.ParseTimezone(c.Query("timezone"))
+	if err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	start, end, err := utils.ParseDateRange(c.Query("from"), c.Query("to"), loc, maxCalendarDays)
+	if err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	days, err := h.projectService.GetTaskCalendar(c.Request.Context(), projectUID, start, end, loc)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to get task calendar")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, days, "")
+}
+
+// GetTaskDefaults handles GET /api/projects/:uid/task-defaults
+func (h *ProjectHandler) GetTaskDefaults(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	defaults, err := h.projectService.GetTaskDefaults(c.Request.Context(), projectUID)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to get task defaults")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, defaults, "")
+}
+
+// UpdateTaskDefaults handles PUT /api/projects/:uid/task-defaults
+func (h *ProjectHandler) UpdateTaskDefaults(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	var req models.TaskDefaultsRequest
+	if err := utils.BindAndValidate(c, &req); err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	defaults, err := h.projectService.UpdateTaskDefaults(c.Request.Context(), projectUID, &req)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to update task defaults")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, defaults, "Task defaults updated successfully")
+}
+
+// QueryTasks handles GET /api/projects/:uid/tasks
+func (h *ProjectHandler) QueryTasks(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	params := services.TaskQueryParams{
+		Status:      c.Query("status"),
+		Priority:    c.Query("priority"),
+		IsCompleted: c.Query("is_completed"),
+		DueBefore:   c.Query("due_before"),
+		DueAfter:    c.Query("due_after"),
+		Sort:        c.Query("sort"),
+	}
+
+	tasks, err := h.projectService.QueryProjectTasks(c.Request.Context(), projectUID, params)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to query project tasks")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, tasks, "")
+}
+
+// GetColorUsage handles GET /api/projects/:uid/colors
+func (h *ProjectHandler) GetColorUsage(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	colors, err := h.projectService.GetColorUsage(c.Request.Context(), projectUID)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to get project colors")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, colors, "")
+}
+
+// GetFlatTasks handles GET /api/projects/:uid/tasks/flat
+func (h *ProjectHandler) GetFlatTasks(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	tasks, err := h.projectService.GetFlatTasks(c.Request.Context(), projectUID)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to get flat task list")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, tasks, "")
+}
+
+// GetTasksWithoutDueDate handles GET /api/projects/:uid/tasks/no-due-date
+func (h *ProjectHandler) GetTasksWithoutDueDate(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	tasks, err := h.projectService.GetTasksWithoutDueDate(c.Request.Context(), projectUID)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to get tasks without due date")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, tasks, "")
+}
+
+// GetOverdueTasks handles GET /api/projects/:uid/tasks/overdue
+func (h *ProjectHandler) GetOverdueTasks(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	loc, err := utils.ParseTimezone(c.Query("timezone"))
+	if err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	overdue, err := h.projectService.GetOverdueTasks(c.Request.Context(), projectUID, loc)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to get overdue tasks")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, overdue, "")
+}
+
+// maxDueDaysRange caps the date range a due-days request may cover
+const maxDueDaysRange = 366
+
+// GetDueDays handles GET /api/projects/:uid/tasks/due-days
+func (h *ProjectHandler) GetDueDays(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	loc, err := utils.ParseTimezone(c.Query("timezone"))
+	if err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	start, end, err := utils.ParseDateRange(c.Query("from"), c.Query("to"), loc, maxDueDaysRange)
+	if err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	days, err := h.projectService.GetDueDays(c.Request.Context(), projectUID, start, end, loc)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to get task due days")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, days, "")
+}
+
+// CloneProject handles POST /api/projects/:uid/clone
+func (h *ProjectHandler) CloneProject(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	logger.WithComponent("project-handler").
+		WithFields(map[string]interface{}{"project_uid": uidParam}).
+		Info("Cloning project")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	// The body is optional; only bind it when one was sent
+	var req models.CloneProjectRequest
+	if c.Request.ContentLength != 0 {
+		if err := utils.BindAndValidate(c, &req); err != nil {
+			utils.SendError(c, err)
+			return
+		}
+	}
+
+	project, err := h.projectService.CloneProject(c.Request.Context(), projectUID, req.Name)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to clone project")
+		utils.SendError(c, err)
+		return
+	}
+
+	logger.WithComponent("project-handler").
+		WithFields(map[string]interface{}{
+			"source_project_uid": projectUID.String(),
+			"project_uid":        project.ProjectUID.String(),
+		}).
+		Info("Successfully cloned project")
+
+	utils.CreatedResponse(c, project, "Project cloned successfully")
+}
+
+// GetBoardSummary handles GET /api/projects/:uid/board-summary
+func (h *ProjectHandler) GetBoardSummary(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	summary, err := h.projectService.GetBoardSummary(c.Request.Context(), projectUID)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to get board summary")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, summary, "")
+}
+
+// CreateSnapshot handles POST /api/projects/:uid/snapshots
+func (h *ProjectHandler) CreateSnapshot(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	snapshot, err := h.projectService.CreateSnapshot(c.Request.Context(), projectUID)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to create project snapshot")
+		utils.SendError(c, err)
+		return
+	}
+
+	logger.WithComponent("project-handler").
+		WithFields(map[string]interface{}{
+			"project_uid":  projectUID.String(),
+			"snapshot_uid": snapshot.SnapshotUID.String(),
+		}).
+		Info("Project snapshot created")
+
+	utils.CreatedResponse(c, snapshot, "Snapshot created successfully")
+}
+
+// GetSnapshots handles GET /api/projects/:uid/snapshots
+func (h *ProjectHandler) GetSnapshots(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	snapshots, err := h.projectService.GetSnapshots(c.Request.Context(), projectUID)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to get project snapshots")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, snapshots, "")
+}
+
+// RestoreSnapshot handles POST /api/projects/:uid/snapshots/:snapshotUid/restore
+func (h *ProjectHandler) RestoreSnapshot(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	snapshotParam := c.Param("snapshotUid")
+	snapshotUID, err := uuid.Parse(snapshotParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": snapshotParam}).
+			Warn("Invalid snapshot UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid snapshot UID format")
+		return
+	}
+
+	project, err := h.projectService.RestoreSnapshot(c.Request.Context(), projectUID, snapshotUID)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid":  projectUID.String(),
+				"snapshot_uid": snapshotUID.String(),
+				"error":        err.Error(),
+			}).
+			Error("Failed to restore project snapshot")
+		utils.SendError(c, err)
+		return
+	}
+
+	logger.WithComponent("project-handler").
+		WithFields(map[string]interface{}{
+			"project_uid":  projectUID.String(),
+			"snapshot_uid": snapshotUID.String(),
+		}).
+		Info("Project snapshot restored")
+
+	utils.SuccessResponse(c, project, "Snapshot restored successfully")
+}
+
+// GetIntegrityReport handles GET /api/projects/:uid/integrity
+func (h *ProjectHandler) GetIntegrityReport(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	report, err := h.projectService.GetIntegrityReport(c.Request.Context(), projectUID)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to check project integrity")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, report, "")
+}
+
+// RepairIntegrity handles POST /api/projects/:uid/integrity/repair
+func (h *ProjectHandler) RepairIntegrity(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	result, err := h.projectService.RepairIntegrity(c.Request.Context(), projectUID)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to repair project integrity")
+		utils.SendError(c, err)
+		return
+	}
+
+	logger.WithComponent("project-handler").
+		WithFields(map[string]interface{}{
+			"project_uid":             projectUID.String(),
+			"tasks_removed":           result.TasksRemoved,
+			"checklist_items_removed": result.ChecklistItemsRemoved,
+			"links_removed":           result.LinksRemoved,
+			"dependencies_removed":    result.DependenciesRemoved,
+		}).
+		Info("Project integrity repaired")
+
+	utils.SuccessResponse(c, result, "Project integrity repaired successfully")
+}
+
+// GetProjectReport handles GET /api/projects/:uid/report
+func (h *ProjectHandler) GetProjectReport(c *gin.Context) {
+	uidParam := c.Param("uid")
+
+	projectUID, err := uuid.Parse(uidParam)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{"invalid_uid": uidParam}).
+			Warn("Invalid project UID format")
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid project UID format")
+		return
+	}
+
+	loc, err := utils.ParseTimezone(c.Query("timezone"))
+	if err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	report, err := h.projectService.GetProjectReport(c.Request.Context(), projectUID, loc)
+	if err != nil {
+		logger.WithComponent("project-handler").
+			WithFields(map[string]interface{}{
+				"project_uid": projectUID.String(),
+				"error":       err.Error(),
+			}).
+			Error("Failed to build project report")
+		utils.SendError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, "text/html; charset=utf-8", report)
+}
@@ -38,6 +38,70 @@ func (h *TaskHandler) CreateTask(c *gin.Context) {
 	utils.CreatedResponse(c, task, "Task created successfully")
 }
 
+// CreateTasksBulk handles POST /api/lists/:uid/tasks/bulk
+func (h *TaskHandler) CreateTasksBulk(c *gin.Context) {
+	uidStr := c.Param("uid")
+	listUID, err := uuid.Parse(uidStr)
+	if err != nil {
+		utils.SendValidationError(c, "Invalid list ID format")
+		return
+	}
+
+	var req models.BulkCreateTasksRequest
+	if err := utils.BindAndValidate(c, &req); err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	tasks, err := h.taskService.CreateTasksBulk(c.Request.Context(), listUID, &req)
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"list_uid": listUID,
+			"count":    len(req.Tasks),
+		}).Error("Failed to bulk create tasks")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, tasks, "Tasks created successfully")
+}
+
+// BulkUpdatePriority handles POST /api/tasks/bulk-priority
+func (h *TaskHandler) BulkUpdatePriority(c *gin.Context) {
+	var req models.BulkPriorityRequest
+	if err := utils.BindAndValidate(c, &req); err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	result, err := h.taskService.BulkUpdatePriority(c.Request.Context(), &req)
+	if err != nil {
+		logrus.WithError(err).WithField("count", len(req.TaskUIDs)).Error("Failed to bulk update task priority")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, result, "Task priorities updated successfully")
+}
+
+// BulkUpdateColor handles POST /api/tasks/bulk-color
+func (h *TaskHandler) BulkUpdateColor(c *gin.Context) {
+	var req models.BulkColorRequest
+	if err := utils.BindAndValidate(c, &req); err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	result, err := h.taskService.BulkUpdateColor(c.Request.Context(), &req)
+	if err != nil {
+		logrus.WithError(err).WithField("count", len(req.TaskUIDs)).Error("Failed to bulk update task color")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, result, "Task colors updated successfully")
+}
+
 // UpdateTask handles PUT /api/tasks/:uid
 func (h *TaskHandler) UpdateTask(c *gin.Context) {
 	uidStr := c.Param("uid")
@@ -131,3 +195,266 @@ func (h *TaskHandler) PartialUpdateTask(c *gin.Context) {
 
 	utils.SuccessResponse(c, task, "Task updated successfully")
 }
+
+// GetOverdueByProject handles GET /api/tasks/overdue/by-project
+func (h *TaskHandler) GetOverdueByProject(c *gin.Context) {
+	groups, err := h.taskService.GetOverdueByProject(c.Request.Context())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get overdue tasks by project")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, groups, "")
+}
+
+// GetTaskHistory handles GET /api/tasks/:uid/history
+func (h *TaskHandler) GetTaskHistory(c *gin.Context) {
+	uidStr := c.Param("uid")
+	uid, err := uuid.Parse(uidStr)
+	if err != nil {
+		utils.SendValidationError(c, "Invalid task ID format")
+		return
+	}
+
+	history, err := h.taskService.GetTaskHistory(c.Request.Context(), uid)
+	if err != nil {
+		logrus.WithError(err).WithField("task_uid", uid).Error("Failed to get task history")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, history, "")
+}
+
+// GetChecklist handles GET /api/tasks/:uid/checklist
+func (h *TaskHandler) GetChecklist(c *gin.Context) {
+	uidStr := c.Param("uid")
+	uid, err := uuid.Parse(uidStr)
+	if err != nil {
+		utils.SendValidationError(c, "Invalid task ID format")
+		return
+	}
+
+	items, err := h.taskService.GetChecklist(c.Request.Context(), uid)
+	if err != nil {
+		logrus.WithError(err).WithField("task_uid", uid).Error("Failed to get checklist")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, items, "")
+}
+
+// AddChecklistItem handles POST /api/tasks/:uid/checklist
+func (h *TaskHandler) AddChecklistItem(c *gin.Context) {
+	uidStr := c.Param("uid")
+	uid, err := uuid.Parse(uidStr)
+	if err != nil {
+		utils.SendValidationError(c, "Invalid task ID format")
+		return
+	}
+
+	var req models.ChecklistItemRequest
+	if err := utils.BindAndValidate(c, &req); err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	item, err := h.taskService.AddChecklistItem(c.Request.Context(), uid, &req)
+	if err != nil {
+		logrus.WithError(err).WithField("task_uid", uid).Error("Failed to add checklist item")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, item, "Checklist item created successfully")
+}
+
+// UpdateChecklistItem handles PATCH /api/tasks/:uid/checklist/:itemUid
+func (h *TaskHandler) UpdateChecklistItem(c *gin.Context) {
+	uid, itemUID, ok := parseChecklistParams(c)
+	if !ok {
+		return
+	}
+
+	var req models.ChecklistItemUpdateRequest
+	if err := utils.BindAndValidate(c, &req); err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	item, err := h.taskService.UpdateChecklistItem(c.Request.Context(), uid, itemUID, &req)
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"task_uid": uid,
+			"item_uid": itemUID,
+		}).Error("Failed to update checklist item")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, item, "Checklist item updated successfully")
+}
+
+// DeleteChecklistItem handles DELETE /api/tasks/:uid/checklist/:itemUid
+func (h *TaskHandler) DeleteChecklistItem(c *gin.Context) {
+	uid, itemUID, ok := parseChecklistParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.taskService.DeleteChecklistItem(c.Request.Context(), uid, itemUID); err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"task_uid": uid,
+			"item_uid": itemUID,
+		}).Error("Failed to delete checklist item")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, nil, "Checklist item deleted successfully")
+}
+
+// parseChecklistParams reads the task and checklist item UIDs from the path,
+// writing a validation error and returning false if either is malformed
+func parseChecklistParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	uid, err := uuid.Parse(c.Param("uid"))
+	if err != nil {
+		utils.SendValidationError(c, "Invalid task ID format")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	itemUID, err := uuid.Parse(c.Param("itemUid"))
+	if err != nil {
+		utils.SendValidationError(c, "Invalid checklist item ID format")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return uid, itemUID, true
+}
+
+// AddDependency handles POST /api/tasks/:uid/dependencies
+func (h *TaskHandler) AddDependency(c *gin.Context) {
+	uidStr := c.Param("uid")
+	uid, err := uuid.Parse(uidStr)
+	if err != nil {
+		utils.SendValidationError(c, "Invalid task ID format")
+		return
+	}
+
+	var req models.TaskDependencyRequest
+	if err := utils.BindAndValidate(c, &req); err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	task, err := h.taskService.AddDependency(c.Request.Context(), uid, &req)
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"task_uid":            uid,
+			"depends_on_task_uid": req.DependsOnTaskUID,
+		}).Error("Failed to add task dependency")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, task, "Dependency added successfully")
+}
+
+// RemoveDependency handles DELETE /api/tasks/:uid/dependencies/:dependsOnUid
+func (h *TaskHandler) RemoveDependency(c *gin.Context) {
+	uid, err := uuid.Parse(c.Param("uid"))
+	if err != nil {
+		utils.SendValidationError(c, "Invalid task ID format")
+		return
+	}
+
+	dependsOnUID, err := uuid.Parse(c.Param("dependsOnUid"))
+	if err != nil {
+		utils.SendValidationError(c, "Invalid dependency task ID format")
+		return
+	}
+
+	task, err := h.taskService.RemoveDependency(c.Request.Context(), uid, dependsOnUID)
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"task_uid":            uid,
+			"depends_on_task_uid": dependsOnUID,
+		}).Error("Failed to remove task dependency")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, task, "Dependency removed successfully")
+}
+
+// GetLinks handles GET /api/tasks/:uid/links
+func (h *TaskHandler) GetLinks(c *gin.Context) {
+	uidStr := c.Param("uid")
+	uid, err := uuid.Parse(uidStr)
+	if err != nil {
+		utils.SendValidationError(c, "Invalid task ID format")
+		return
+	}
+
+	links, err := h.taskService.GetLinks(c.Request.Context(), uid)
+	if err != nil {
+		logrus.WithError(err).WithField("task_uid", uid).Error("Failed to get task links")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, links, "")
+}
+
+// AddLink handles POST /api/tasks/:uid/links
+func (h *TaskHandler) AddLink(c *gin.Context) {
+	uidStr := c.Param("uid")
+	uid, err := uuid.Parse(uidStr)
+	if err != nil {
+		utils.SendValidationError(c, "Invalid task ID format")
+		return
+	}
+
+	var req models.TaskLinkRequest
+	if err := utils.BindAndValidate(c, &req); err != nil {
+		utils.SendError(c, err)
+		return
+	}
+
+	link, err := h.taskService.AddLink(c.Request.Context(), uid, &req)
+	if err != nil {
+		logrus.WithError(err).WithField("task_uid", uid).Error("Failed to add task link")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, link, "Link added successfully")
+}
+
+// RemoveLink handles DELETE /api/tasks/:uid/links/:linkUid
+func (h *TaskHandler) RemoveLink(c *gin.Context) {
+	uid, err := uuid.Parse(c.Param("uid"))
+	if err != nil {
+		utils.SendValidationError(c, "Invalid task ID format")
+		return
+	}
+
+	linkUID, err := uuid.Parse(c.Param("linkUid"))
+	if err != nil {
+		utils.SendValidationError(c, "Invalid link ID format")
+		return
+	}
+
+	if err := h.taskService.RemoveLink(c.Request.Context(), uid, linkUID); err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"task_uid": uid,
+			"link_uid": linkUID,
+		}).Error("Failed to remove task link")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, nil, "Link removed successfully")
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"lucid-lists-backend/internal/services"
+	"lucid-lists-backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type TemplateHandler struct {
+	templateService *services.TemplateService
+}
+
+func NewTemplateHandler(templateService *services.TemplateService) *TemplateHandler {
+	return &TemplateHandler{
+		templateService: templateService,
+	}
+}
+
+// GetTemplates handles GET /api/templates
+func (h *TemplateHandler) GetTemplates(c *gin.Context) {
+	templates, err := h.templateService.GetTemplates(c.Request.Context())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get templates")
+		utils.SendError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, templates, "")
+}
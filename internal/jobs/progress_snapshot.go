@@ -0,0 +1,63 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"lucid-lists-backend/internal/repositories"
+	"lucid-lists-backend/pkg/logger"
+)
+
+// ProgressSnapshotJob records a daily task completion snapshot for every active project
+type ProgressSnapshotJob struct {
+	snapshotRepo repositories.ProgressSnapshotRepository
+	now          func() time.Time
+}
+
+func NewProgressSnapshotJob(snapshotRepo repositories.ProgressSnapshotRepository) *ProgressSnapshotJob {
+	return &ProgressSnapshotJob{
+		snapshotRepo: snapshotRepo,
+		now:          time.Now,
+	}
+}
+
+// RunOnce records today's snapshot for all projects
+func (j *ProgressSnapshotJob) RunOnce(ctx context.Context) error {
+	today := j.now().UTC().Truncate(24 * time.Hour)
+
+	count, err := j.snapshotRepo.RecordForAllProjects(ctx, today)
+	if err != nil {
+		return err
+	}
+
+	logger.WithComponent("progress-snapshot").
+		WithFields(map[string]interface{}{
+			"date":     today.Format("2006-01-02"),
+			"projects": count,
+		}).
+		Info("Recorded project progress snapshots")
+
+	return nil
+}
+
+// Start runs the job immediately and then on every interval until ctx is cancelled
+func (j *ProgressSnapshotJob) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := j.RunOnce(ctx); err != nil {
+				logger.WithComponent("progress-snapshot").
+					WithFields(map[string]interface{}{"error": err.Error()}).
+					Error("Failed to record project progress snapshots")
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
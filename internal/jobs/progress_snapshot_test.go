@@ -0,0 +1,129 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/internal/repositories"
+)
+
+type snapshotKey struct {
+	projectID int
+	date      string
+}
+
+// fakeSnapshotRepo upserts one row per project and date, like the unique
+// (project_id, snapshot_date) constraint in Postgres
+type fakeSnapshotRepo struct {
+	repositories.ProgressSnapshotRepository
+	counts map[int][2]int
+	rows   map[snapshotKey]models.ProjectProgressSnapshot
+	dates  []time.Time
+	err    error
+}
+
+func newFakeSnapshotRepo() *fakeSnapshotRepo {
+	return &fakeSnapshotRepo{
+		counts: map[int][2]int{},
+		rows:   map[snapshotKey]models.ProjectProgressSnapshot{},
+	}
+}
+
+func (r *fakeSnapshotRepo) RecordForAllProjects(ctx context.Context, date time.Time) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	r.dates = append(r.dates, date)
+	for projectID, counts := range r.counts {
+		r.rows[snapshotKey{projectID, date.Format("2006-01-02")}] = models.ProjectProgressSnapshot{
+			ProjectID:      projectID,
+			SnapshotDate:   date,
+			TotalTasks:     counts[0],
+			CompletedTasks: counts[1],
+		}
+	}
+	return len(r.counts), nil
+}
+
+func newTestJob(repo *fakeSnapshotRepo, now *time.Time) *ProgressSnapshotJob {
+	job := NewProgressSnapshotJob(repo)
+	job.now = func() time.Time { return *now }
+	return job
+}
+
+func TestRunOnceRecordsOneSnapshotPerProject(t *testing.T) {
+	repo := newFakeSnapshotRepo()
+	repo.counts[1] = [2]int{4, 1}
+	repo.counts[2] = [2]int{2, 2}
+
+	// 23:30 on 9 March in UTC-5 is already 10 March in UTC
+	now := time.Date(2026, 3, 9, 23, 30, 0, 0, time.FixedZone("UTC-5", -5*60*60))
+	job := newTestJob(repo, &now)
+
+	if err := job.RunOnce(context.Background()); err != nil {
+		t.Fatalf("RunOnce: %v", err)
+	}
+
+	want := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	if len(repo.dates) != 1 || !repo.dates[0].Equal(want) || repo.dates[0].Location() != time.UTC {
+		t.Fatalf("expected the snapshot date %v, got %v", want, repo.dates)
+	}
+
+	if len(repo.rows) != 2 {
+		t.Fatalf("expected 2 snapshot rows, got %d", len(repo.rows))
+	}
+	row := repo.rows[snapshotKey{1, "2026-03-10"}]
+	if row.TotalTasks != 4 || row.CompletedTasks != 1 {
+		t.Errorf("unexpected snapshot for project 1: %+v", row)
+	}
+}
+
+func TestRunOnceIsIdempotentWithinADay(t *testing.T) {
+	repo := newFakeSnapshotRepo()
+	repo.counts[1] = [2]int{4, 1}
+
+	now := time.Date(2026, 3, 10, 8, 0, 0, 0, time.UTC)
+	job := newTestJob(repo, &now)
+
+	if err := job.RunOnce(context.Background()); err != nil {
+		t.Fatalf("RunOnce: %v", err)
+	}
+
+	repo.counts[1] = [2]int{4, 3}
+	now = now.Add(6 * time.Hour)
+	if err := job.RunOnce(context.Background()); err != nil {
+		t.Fatalf("RunOnce: %v", err)
+	}
+
+	if len(repo.rows) != 1 {
+		t.Fatalf("expected a second run on the same day to overwrite, got %d rows", len(repo.rows))
+	}
+	if row := repo.rows[snapshotKey{1, "2026-03-10"}]; row.CompletedTasks != 3 {
+		t.Errorf("expected the latest counts, got %+v", row)
+	}
+	if !repo.dates[0].Equal(repo.dates[1]) {
+		t.Errorf("expected both runs to use the same date, got %v", repo.dates)
+	}
+
+	now = now.Add(24 * time.Hour)
+	if err := job.RunOnce(context.Background()); err != nil {
+		t.Fatalf("RunOnce: %v", err)
+	}
+	if len(repo.rows) != 2 {
+		t.Errorf("expected a new row on the next day, got %d rows", len(repo.rows))
+	}
+}
+
+func TestRunOnceReturnsRepositoryError(t *testing.T) {
+	repo := newFakeSnapshotRepo()
+	repo.err = errors.New("connection refused")
+
+	now := time.Now()
+	if err := newTestJob(repo, &now).RunOnce(context.Background()); !errors.Is(err, repo.err) {
+		t.Fatalf("expected the repository error, got %v", err)
+	}
+}
@@ -32,6 +32,7 @@ type Project struct {
 	Position    *int       `db:"position"`
 	StartDate   *time.Time `db:"start_date"`
 	EndDate     *time.Time `db:"end_date"`
+	ArchivedAt  *time.Time `db:"archived_at"`
 	CreatedAt   time.Time  `db:"created_at"`
 	CreatedBy   *uuid.UUID `db:"created_by"`
 	UpdatedAt   *time.Time `db:"updated_at"`
@@ -39,6 +40,39 @@ type Project struct {
 	IsActive    bool       `db:"is_active"`
 }
 
+// ProjectCounts holds aggregate list and task counts for a project
+type ProjectCounts struct {
+	ListCount int
+	TaskCount int
+}
+
+// TaskFilter narrows and orders a task query within a project. Nil fields are not filtered on.
+type TaskFilter struct {
+	Statuses    []string
+	Priorities  []string
+	IsCompleted *bool
+	DueBefore   *time.Time
+	DueAfter    *time.Time
+	HasDueDate  *bool
+	Sort        string
+}
+
+// ProjectTaskDefaults holds the values applied to new tasks in a project when the request omits them
+type ProjectTaskDefaults struct {
+	ProjectID int        `db:"project_id"`
+	Priority  *string    `db:"priority"`
+	Status    *string    `db:"status"`
+	Color     *string    `db:"color"`
+	UpdatedAt *time.Time `db:"updated_at"`
+}
+
+// DailyTaskCounts holds how many tasks were created and completed on one day
+type DailyTaskCounts struct {
+	Day       time.Time
+	Created   int
+	Completed int
+}
+
 type List struct {
 	ID        int        `db:"id"`
 	ListUID   uuid.UUID  `db:"list_uid"`
@@ -53,22 +87,161 @@ type List struct {
 	IsActive  bool       `db:"is_active"`
 }
 
+// ListWithTasks groups a list with its tasks for operations that write a whole board at once
+type ListWithTasks struct {
+	List  List
+	Tasks []Task
+}
+
 type Task struct {
+	ID          int         `db:"id"`
+	TaskUID     uuid.UUID   `db:"task_uid"`
+	ListID      int         `db:"list_id"`
+	Title       string      `db:"title"`
+	Description *string     `db:"description"`
+	Priority    *string     `db:"priority"`
+	Status      string      `db:"status"`
+	Color       string      `db:"color"`
+	Position    *int        `db:"position"`
+	IsCompleted bool        `db:"is_completed"`
+	DueDate     *time.Time  `db:"due_date"`
+	CompletedAt *time.Time  `db:"completed_at"`
+	ArchivedAt  *time.Time  `db:"archived_at"`
+	Recurrence  *Recurrence `db:"recurrence"`
+	CreatedAt   time.Time   `db:"created_at"`
+	CreatedBy   *uuid.UUID  `db:"created_by"`
+	UpdatedAt   *time.Time  `db:"updated_at"`
+	UpdatedBy   *uuid.UUID  `db:"updated_by"`
+	IsActive    bool        `db:"is_active"`
+}
+
+// Recurrence describes how often a recurring task comes back once completed.
+// It is stored as JSON in task.recurrence.
+type Recurrence struct {
+	Freq     string `json:"freq" validate:"required,oneof=daily weekly monthly yearly"`
+	Interval int    `json:"interval" validate:"required,min=1,max=365"`
+}
+
+type ProjectProgressSnapshot struct {
+	ID             int       `db:"id"`
+	ProjectID      int       `db:"project_id"`
+	SnapshotDate   time.Time `db:"snapshot_date"`
+	TotalTasks     int       `db:"total_tasks"`
+	CompletedTasks int       `db:"completed_tasks"`
+	CreatedAt      time.Time `db:"created_at"`
+}
+
+// TaskFieldChange describes a single task whose field was changed by a bulk update
+type TaskFieldChange struct {
+	TaskID   int
+	TaskUID  uuid.UUID
+	OldValue *string
+}
+
+type ChecklistItem struct {
 	ID          int        `db:"id"`
-	TaskUID     uuid.UUID  `db:"task_uid"`
-	ListID      int        `db:"list_id"`
+	ItemUID     uuid.UUID  `db:"item_uid"`
+	TaskID      int        `db:"task_id"`
 	Title       string     `db:"title"`
-	Description *string    `db:"description"`
-	Priority    *string    `db:"priority"`
-	Status      string     `db:"status"`
-	Color       string     `db:"color"`
-	Position    *int       `db:"position"`
 	IsCompleted bool       `db:"is_completed"`
-	DueDate     *time.Time `db:"due_date"`
-	CompletedAt *time.Time `db:"completed_at"`
+	Position    int        `db:"position"`
 	CreatedAt   time.Time  `db:"created_at"`
-	CreatedBy   *uuid.UUID `db:"created_by"`
 	UpdatedAt   *time.Time `db:"updated_at"`
-	UpdatedBy   *uuid.UUID `db:"updated_by"`
 	IsActive    bool       `db:"is_active"`
 }
+
+type TaskLink struct {
+	ID        int        `db:"id"`
+	LinkUID   uuid.UUID  `db:"link_uid"`
+	TaskID    int        `db:"task_id"`
+	URL       string     `db:"url"`
+	Title     *string    `db:"title"`
+	CreatedAt time.Time  `db:"created_at"`
+	CreatedBy *uuid.UUID `db:"created_by"`
+	IsActive  bool       `db:"is_active"`
+}
+
+type TaskHistory struct {
+	ID        int        `db:"id"`
+	TaskID    int        `db:"task_id"`
+	Field     string     `db:"field"`
+	OldValue  *string    `db:"old_value"`
+	NewValue  *string    `db:"new_value"`
+	ChangedBy *uuid.UUID `db:"changed_by"`
+	ChangedAt time.Time  `db:"changed_at"`
+}
+
+// TaskActivity is a task history entry together with the task it belongs to
+type TaskActivity struct {
+	TaskHistory
+	TaskUID   uuid.UUID `db:"task_uid"`
+	TaskTitle string    `db:"title"`
+}
+
+type ProjectTemplate struct {
+	ID          int       `db:"id"`
+	TemplateUID uuid.UUID `db:"template_uid"`
+	Name        string    `db:"name"`
+	Description *string   `db:"description"`
+	Body        []byte    `db:"body"`
+	CreatedAt   time.Time `db:"created_at"`
+	IsActive    bool      `db:"is_active"`
+}
+
+type ProjectStateSnapshot struct {
+	ID          int       `db:"id"`
+	SnapshotUID uuid.UUID `db:"snapshot_uid"`
+	ProjectID   int       `db:"project_id"`
+	State       []byte    `db:"state"`
+	ListCount   int       `db:"list_count"`
+	TaskCount   int       `db:"task_count"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// ProjectState is the JSON structure stored in project_state_snapshot.state
+type ProjectState struct {
+	Lists []ProjectStateList `json:"lists"`
+}
+
+type ProjectStateList struct {
+	ListUID  uuid.UUID          `json:"list_uid"`
+	Name     string             `json:"name"`
+	Color    string             `json:"color"`
+	Position int                `json:"position"`
+	Tasks    []ProjectStateTask `json:"tasks"`
+}
+
+type ProjectStateTask struct {
+	TaskUID     uuid.UUID   `json:"task_uid"`
+	Title       string      `json:"title"`
+	Description *string     `json:"description,omitempty"`
+	Priority    *string     `json:"priority,omitempty"`
+	Status      string      `json:"status"`
+	Color       string      `json:"color"`
+	Position    *int        `json:"position,omitempty"`
+	IsCompleted bool        `json:"is_completed"`
+	DueDate     *time.Time  `json:"due_date,omitempty"`
+	CompletedAt *time.Time  `json:"completed_at,omitempty"`
+	ArchivedAt  *time.Time  `json:"archived_at,omitempty"`
+	Recurrence  *Recurrence `json:"recurrence,omitempty"`
+}
+
+// TemplateBody is the JSON structure stored in project_template.body.
+// It is validated with the rules ListRequest and TaskRequest apply, so a template
+// can only seed lists and tasks the API would accept.
+type TemplateBody struct {
+	Lists []TemplateList `json:"lists" validate:"dive"`
+}
+
+type TemplateList struct {
+	Name  string         `json:"name" validate:"required,min=1,max=255"`
+	Color string         `json:"color,omitempty" validate:"omitempty,len=7,startswith=#"`
+	Tasks []TemplateTask `json:"tasks" validate:"dive"`
+}
+
+type TemplateTask struct {
+	Title       string  `json:"title" validate:"required,min=1,max=255"`
+	Description *string `json:"description,omitempty"`
+	Priority    *string `json:"priority,omitempty" validate:"omitempty,oneof=low medium high"`
+	Color       string  `json:"color,omitempty" validate:"omitempty,len=7,startswith=#"`
+}
@@ -28,8 +28,53 @@ type ProjectResponse struct {
 	Position    *int       `json:"position"`
 	StartDate   *time.Time `json:"start_date"`
 	EndDate     *time.Time `json:"end_date"`
+	ArchivedAt  *time.Time `json:"archived_at"`
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   *time.Time `json:"updated_at"`
+	ListCount   *int       `json:"list_count,omitempty"`
+	TaskCount   *int       `json:"task_count,omitempty"`
+}
+
+type ProjectReorderItem struct {
+	ProjectUID uuid.UUID `json:"project_uid" validate:"required"`
+	Position   int       `json:"position" validate:"min=0"`
+}
+
+type ProjectReorderRequest struct {
+	Items []ProjectReorderItem `json:"items" validate:"required,min=1,max=500,dive"`
+}
+
+// BoardReorderList is the full task order of one list; tasks named here are moved into the list
+type BoardReorderList struct {
+	ListUID  uuid.UUID   `json:"list_uid" validate:"required"`
+	TaskUIDs []uuid.UUID `json:"task_uids" validate:"max=1000"`
+}
+
+type BoardReorderRequest struct {
+	Lists []BoardReorderList `json:"lists" validate:"required,min=1,max=100,dive"`
+}
+
+type CloneProjectRequest struct {
+	Name *string `json:"name" validate:"omitempty,min=1,max=255"`
+}
+
+type ProjectArchiveRequest struct {
+	Archived *bool `json:"archived" validate:"required"`
+}
+
+type ProjectStatusCountsResponse struct {
+	Active    int `json:"active"`
+	Inactive  int `json:"inactive"`
+	Completed int `json:"completed"`
+}
+
+type ArchiveCompletedTasksResponse struct {
+	Archived int `json:"archived"`
+}
+
+type ProjectNameAvailabilityResponse struct {
+	Name      string `json:"name"`
+	Available bool   `json:"available"`
 }
 
 type ProjectWithListsResponse struct {
@@ -44,6 +89,15 @@ type ListRequest struct {
 	Position   int       `json:"position" validate:"min=0"`
 }
 
+type BulkListItem struct {
+	Name  string `json:"name" validate:"required,min=1,max=255"`
+	Color string `json:"color" validate:"omitempty,len=7,startswith=#"`
+}
+
+type BulkCreateListsRequest struct {
+	Lists []BulkListItem `json:"lists" validate:"required,min=1,dive"`
+}
+
 type ListResponse struct {
 	ListUID   uuid.UUID  `json:"list_uid"`
 	Name      string     `json:"name"`
@@ -53,41 +107,136 @@ type ListResponse struct {
 	UpdatedAt *time.Time `json:"updated_at"`
 }
 
+type ListSummaryResponse struct {
+	ListUID        uuid.UUID `json:"list_uid"`
+	Name           string    `json:"name"`
+	Color          string    `json:"color"`
+	Position       int       `json:"position"`
+	TotalTasks     int       `json:"total_tasks"`
+	CompletedTasks int       `json:"completed_tasks"`
+}
+
 type ListWithTasksResponse struct {
 	ListResponse
 	Tasks []TaskResponse `json:"tasks"`
 }
 
 type TaskRequest struct {
-	ListUID     uuid.UUID  `json:"list_uid" validate:"required"`
+	ListUID     uuid.UUID   `json:"list_uid" validate:"required"`
+	Title       string      `json:"title" validate:"required,min=1,max=255"`
+	Description *string     `json:"description"`
+	Priority    *string     `json:"priority" validate:"omitempty,oneof=low medium high"`
+	Status      string      `json:"status" validate:"omitempty,oneof=todo in_progress completed"`
+	Color       string      `json:"color" validate:"omitempty,len=7,startswith=#"`
+	Position    *int        `json:"position" validate:"omitempty,min=0"`
+	IsCompleted *bool       `json:"is_completed"`
+	DueDate     *time.Time  `json:"due_date"`
+	Recurrence  *Recurrence `json:"recurrence"`
+}
+
+type TaskResponse struct {
+	TaskUID     uuid.UUID   `json:"task_uid"`
+	Title       string      `json:"title"`
+	Description *string     `json:"description"`
+	Priority    *string     `json:"priority"`
+	Status      string      `json:"status"`
+	Color       string      `json:"color"`
+	Position    *int        `json:"position"`
+	IsCompleted bool        `json:"is_completed"`
+	DueDate     *time.Time  `json:"due_date"`
+	CompletedAt *time.Time  `json:"completed_at"`
+	ArchivedAt  *time.Time  `json:"archived_at"`
+	Recurrence  *Recurrence `json:"recurrence"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   *time.Time  `json:"updated_at"`
+
+	Checklist []ChecklistItemResponse `json:"checklist,omitempty"`
+	DependsOn []uuid.UUID             `json:"depends_on,omitempty"`
+	Links     []TaskLinkResponse      `json:"links,omitempty"`
+}
+
+type TaskLinkRequest struct {
+	URL   string  `json:"url" validate:"required,http_url,max=2048"`
+	Title *string `json:"title" validate:"omitempty,max=255"`
+}
+
+type TaskLinkResponse struct {
+	LinkUID   uuid.UUID `json:"link_uid"`
+	URL       string    `json:"url"`
+	Title     *string   `json:"title"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type TaskDependencyRequest struct {
+	DependsOnTaskUID uuid.UUID `json:"depends_on_task_uid" validate:"required"`
+}
+
+type ChecklistItemRequest struct {
+	Title       string `json:"title" validate:"required,min=1,max=255"`
+	IsCompleted *bool  `json:"is_completed"`
+	Position    *int   `json:"position" validate:"omitempty,min=0"`
+}
+
+type ChecklistItemUpdateRequest struct {
+	Title       *string `json:"title" validate:"omitempty,min=1,max=255"`
+	IsCompleted *bool   `json:"is_completed"`
+	Position    *int    `json:"position" validate:"omitempty,min=0"`
+}
+
+type ChecklistItemResponse struct {
+	ItemUID     uuid.UUID  `json:"item_uid"`
+	Title       string     `json:"title"`
+	IsCompleted bool       `json:"is_completed"`
+	Position    int        `json:"position"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+}
+
+type TaskDefaultsRequest struct {
+	Priority *string `json:"priority" validate:"omitempty,oneof=low medium high"`
+	Status   *string `json:"status" validate:"omitempty,oneof=todo in_progress completed"`
+	Color    *string `json:"color" validate:"omitempty,len=7,startswith=#"`
+}
+
+type TaskDefaultsResponse struct {
+	Priority  *string    `json:"priority"`
+	Status    *string    `json:"status"`
+	Color     *string    `json:"color"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+type BulkTaskItem struct {
 	Title       string     `json:"title" validate:"required,min=1,max=255"`
 	Description *string    `json:"description"`
 	Priority    *string    `json:"priority" validate:"omitempty,oneof=low medium high"`
-	Status      string     `json:"status" validate:"oneof=todo in_progress completed"`
+	Status      string     `json:"status" validate:"omitempty,oneof=todo in_progress completed"`
 	Color       string     `json:"color" validate:"omitempty,len=7,startswith=#"`
-	Position    *int       `json:"position" validate:"omitempty,min=0"`
 	IsCompleted *bool      `json:"is_completed"`
 	DueDate     *time.Time `json:"due_date"`
 }
 
-type TaskResponse struct {
-	TaskUID     uuid.UUID  `json:"task_uid"`
-	Title       string     `json:"title"`
-	Description *string    `json:"description"`
-	Priority    *string    `json:"priority"`
-	Status      string     `json:"status"`
-	Color       string     `json:"color"`
-	Position    *int       `json:"position"`
-	IsCompleted bool       `json:"is_completed"`
-	DueDate     *time.Time `json:"due_date"`
-	CompletedAt *time.Time `json:"completed_at"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   *time.Time `json:"updated_at"`
+type BulkCreateTasksRequest struct {
+	Tasks []BulkTaskItem `json:"tasks" validate:"required,min=1,dive"`
+}
+
+type BulkPriorityRequest struct {
+	TaskUIDs []uuid.UUID `json:"task_uids" validate:"required,min=1,max=500"`
+	Priority string      `json:"priority" validate:"required,oneof=low medium high"`
+}
+
+type BulkColorRequest struct {
+	TaskUIDs []uuid.UUID `json:"task_uids" validate:"required,min=1,max=500"`
+	Color    string      `json:"color" validate:"required,len=7,startswith=#,hexcolor"`
+}
+
+type BulkUpdateResponse struct {
+	Updated int         `json:"updated"`
+	Failed  []uuid.UUID `json:"failed"`
 }
 
 type MoveTaskRequest struct {
 	ListUID  uuid.UUID `json:"list_uid" validate:"required"`
-	Position *int      `json:"position"`
+	Position *int      `json:"position" validate:"omitempty,min=1"`
 }
 
 type UpdatePositionRequest struct {
@@ -112,12 +261,137 @@ type ListUpdateRequest struct {
 }
 
 type TaskUpdateRequest struct {
-	Title       *string    `json:"title,omitempty" validate:"omitempty,min=1,max=255"`
-	Description *string    `json:"description,omitempty"`
-	Priority    *string    `json:"priority,omitempty" validate:"omitempty,oneof=low medium high"`
-	Status      *string    `json:"status,omitempty" validate:"omitempty,oneof=todo in_progress completed"`
-	Color       *string    `json:"color,omitempty" validate:"omitempty,len=7,startswith=#"`
-	Position    *int       `json:"position,omitempty" validate:"omitempty,min=0"`
-	IsCompleted *bool      `json:"is_completed,omitempty"`
-	DueDate     *time.Time `json:"due_date,omitempty"`
+	Title       *string     `json:"title,omitempty" validate:"omitempty,min=1,max=255"`
+	Description *string     `json:"description,omitempty"`
+	Priority    *string     `json:"priority,omitempty" validate:"omitempty,oneof=low medium high"`
+	Status      *string     `json:"status,omitempty" validate:"omitempty,oneof=todo in_progress completed"`
+	Color       *string     `json:"color,omitempty" validate:"omitempty,len=7,startswith=#"`
+	Position    *int        `json:"position,omitempty" validate:"omitempty,min=0"`
+	IsCompleted *bool       `json:"is_completed,omitempty"`
+	DueDate     *time.Time  `json:"due_date,omitempty"`
+	Recurrence  *Recurrence `json:"recurrence,omitempty"`
+}
+
+type ProjectOverdueResponse struct {
+	ProjectUID   uuid.UUID      `json:"project_uid"`
+	ProjectName  string         `json:"project_name"`
+	OverdueCount int            `json:"overdue_count"`
+	Tasks        []TaskResponse `json:"tasks"`
+}
+
+type ListTrendDayResponse struct {
+	Date      string `json:"date"`
+	Created   int    `json:"created"`
+	Completed int    `json:"completed"`
+}
+
+type ProgressSnapshotResponse struct {
+	Date               string  `json:"date"`
+	TotalTasks         int     `json:"total_tasks"`
+	CompletedTasks     int     `json:"completed_tasks"`
+	ProgressPercentage float64 `json:"progress_percentage"`
+}
+
+type ProjectSnapshotResponse struct {
+	SnapshotUID uuid.UUID `json:"snapshot_uid"`
+	ListCount   int       `json:"list_count"`
+	TaskCount   int       `json:"task_count"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type IntegrityReportResponse struct {
+	OrphanedTasks          []OrphanedTaskResponse `json:"orphaned_tasks"`
+	OrphanedChecklistItems int                    `json:"orphaned_checklist_items"`
+	OrphanedLinks          int                    `json:"orphaned_links"`
+	OrphanedDependencies   int                    `json:"orphaned_dependencies"`
+}
+
+// OrphanedTaskResponse is an active task whose list has been deleted
+type OrphanedTaskResponse struct {
+	TaskUID  uuid.UUID `json:"task_uid"`
+	Title    string    `json:"title"`
+	ListUID  uuid.UUID `json:"list_uid"`
+	ListName string    `json:"list_name"`
+}
+
+type IntegrityRepairResponse struct {
+	TasksRemoved          int `json:"tasks_removed"`
+	ChecklistItemsRemoved int `json:"checklist_items_removed"`
+	LinksRemoved          int `json:"links_removed"`
+	DependenciesRemoved   int `json:"dependencies_removed"`
+}
+
+type TaskHistoryResponse struct {
+	Field     string     `json:"field"`
+	OldValue  *string    `json:"old_value"`
+	NewValue  *string    `json:"new_value"`
+	ChangedBy *uuid.UUID `json:"changed_by"`
+	ChangedAt time.Time  `json:"changed_at"`
+}
+
+// FlatTaskResponse is a print-friendly task row carrying its list name
+type FlatTaskResponse struct {
+	ListUID     uuid.UUID  `json:"list_uid"`
+	ListName    string     `json:"list_name"`
+	TaskUID     uuid.UUID  `json:"task_uid"`
+	Title       string     `json:"title"`
+	Description *string    `json:"description"`
+	Priority    *string    `json:"priority"`
+	Status      string     `json:"status"`
+	IsCompleted bool       `json:"is_completed"`
+	DueDate     *time.Time `json:"due_date"`
+}
+
+type ColorUsageResponse struct {
+	Color     string `json:"color"`
+	Count     int    `json:"count"`
+	ListCount int    `json:"list_count"`
+	TaskCount int    `json:"task_count"`
+}
+
+type OverdueTasksResponse struct {
+	Count int            `json:"count"`
+	Tasks []TaskResponse `json:"tasks"`
+}
+
+type DueDayResponse struct {
+	Date  string `json:"date"`
+	Count int    `json:"count"`
+}
+
+type CalendarDayResponse struct {
+	Date  string         `json:"date"`
+	Tasks []TaskResponse `json:"tasks"`
+}
+
+type TemplateResponse struct {
+	TemplateUID uuid.UUID      `json:"template_uid"`
+	Name        string         `json:"name"`
+	Description *string        `json:"description"`
+	Lists       []TemplateList `json:"lists"`
+}
+
+type ProjectFromTemplateRequest struct {
+	TemplateUID uuid.UUID `json:"template_uid" validate:"required"`
+	Name        string    `json:"name" validate:"required,min=1,max=255"`
+	Description *string   `json:"description"`
+	Color       string    `json:"color" validate:"omitempty,len=7,startswith=#"`
+}
+
+// MetaResponse describes the API version and which optional integrations this deployment has enabled
+type MetaResponse struct {
+	APIVersion string       `json:"api_version"`
+	Features   MetaFeatures `json:"features"`
+}
+
+type MetaFeatures struct {
+	AI       bool `json:"ai"`
+	Webhooks bool `json:"webhooks"`
+	Realtime bool `json:"realtime"`
+}
+
+type ServerTimeResponse struct {
+	ServerUTC time.Time `json:"server_utc"`
+	UserLocal time.Time `json:"user_local"`
+	Timezone  string    `json:"timezone"`
 }
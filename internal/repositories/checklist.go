@@ -0,0 +1,241 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"lucid-lists-backend/internal/models"
+)
+
+type checklistRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewChecklistRepository(db *pgxpool.Pool) ChecklistRepository {
+	return &checklistRepository{db: db}
+}
+
+const checklistColumns = `id, item_uid, task_id, title, is_completed, position, created_at, updated_at, is_active`
+
+func scanChecklistItem(row rowScanner, item *models.ChecklistItem) error {
+	return row.Scan(
+		&item.ID, &item.ItemUID, &item.TaskID, &item.Title, &item.IsCompleted,
+		&item.Position, &item.CreatedAt, &item.UpdatedAt, &item.IsActive,
+	)
+}
+
+func (r *checklistRepository) GetByTaskID(ctx context.Context, taskID int) ([]models.ChecklistItem, error) {
+	query := `
+		SELECT ` + checklistColumns + `
+		FROM task_checklist_item
+		WHERE task_id = $1 AND is_active = true
+		ORDER BY position ASC, id ASC`
+
+	rows, err := r.db.Query(ctx, query, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query checklist items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []models.ChecklistItem
+	for rows.Next() {
+		var item models.ChecklistItem
+		if err := scanChecklistItem(rows, &item); err != nil {
+			return nil, fmt.Errorf("failed to scan checklist item: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (r *checklistRepository) GetByUID(ctx context.Context, taskID int, uid uuid.UUID) (*models.ChecklistItem, error) {
+	query := `
+		SELECT ` + checklistColumns + `
+		FROM task_checklist_item
+		WHERE task_id = $1 AND item_uid = $2 AND is_active = true`
+
+	var item models.ChecklistItem
+	if err := scanChecklistItem(r.db.QueryRow(ctx, query, taskID, uid), &item); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("checklist item not found")
+		}
+		return nil, fmt.Errorf("failed to get checklist item: %w", err)
+	}
+
+	return &item, nil
+}
+
+// Create adds the item to the task's checklist at position, or appends it when position is nil.
+// Items at or after the position shift down one place in the same transaction.
+func (r *checklistRepository) Create(ctx context.Context, item *models.ChecklistItem, position *int) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	ids, err := lockChecklistOrder(ctx, tx, item.TaskID, 0)
+	if err != nil {
+		return err
+	}
+
+	insertAt := len(ids)
+	if position != nil && *position < insertAt {
+		insertAt = *position
+	}
+
+	query := `
+		INSERT INTO task_checklist_item (item_uid, task_id, title, is_completed, position)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at`
+
+	err = tx.QueryRow(ctx, query, item.ItemUID, item.TaskID, item.Title, item.IsCompleted, insertAt).
+		Scan(&item.ID, &item.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create checklist item: %w", err)
+	}
+
+	if insertAt < len(ids) {
+		if err := writeChecklistOrder(ctx, tx, insertChecklistID(ids, item.ID, insertAt)); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit checklist item: %w", err)
+	}
+
+	item.Position = insertAt
+	item.IsActive = true
+	return nil
+}
+
+func (r *checklistRepository) Update(ctx context.Context, item *models.ChecklistItem) error {
+	query := `
+		UPDATE task_checklist_item
+		SET title = $2, is_completed = $3, updated_at = $4
+		WHERE id = $1 AND is_active = true`
+
+	now := time.Now()
+	result, err := r.db.Exec(ctx, query, item.ID, item.Title, item.IsCompleted, now)
+	if err != nil {
+		return fmt.Errorf("failed to update checklist item: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("checklist item not found")
+	}
+
+	item.UpdatedAt = &now
+	return nil
+}
+
+// MoveTo places the item at position within its task's checklist and renumbers the
+// remaining items so positions stay contiguous from zero.
+func (r *checklistRepository) MoveTo(ctx context.Context, taskID int, itemID int, position int) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	ids, err := lockChecklistOrder(ctx, tx, taskID, itemID)
+	if err != nil {
+		return err
+	}
+
+	if position > len(ids) {
+		position = len(ids)
+	}
+
+	if err := writeChecklistOrder(ctx, tx, insertChecklistID(ids, itemID, position)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit checklist reorder: %w", err)
+	}
+
+	return nil
+}
+
+// lockChecklistOrder locks the task row, serialising checklist writes for the task even while
+// its checklist is empty, and returns the ids of its active items except excludeID in display order.
+func lockChecklistOrder(ctx context.Context, tx pgx.Tx, taskID int, excludeID int) ([]int, error) {
+	if _, err := tx.Exec(ctx, `SELECT id FROM task WHERE id = $1 FOR UPDATE`, taskID); err != nil {
+		return nil, fmt.Errorf("failed to lock task: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT id
+		FROM task_checklist_item
+		WHERE task_id = $1 AND is_active = true AND id <> $2
+		ORDER BY position ASC, id ASC
+		FOR UPDATE`, taskID, excludeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query checklist items: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan checklist item: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query checklist items: %w", err)
+	}
+
+	return ids, nil
+}
+
+// insertChecklistID returns ids with id inserted at index position
+func insertChecklistID(ids []int, id int, position int) []int {
+	ordered := make([]int, 0, len(ids)+1)
+	ordered = append(ordered, ids[:position]...)
+	ordered = append(ordered, id)
+	return append(ordered, ids[position:]...)
+}
+
+// writeChecklistOrder numbers the items from zero in the given order, skipping rows already in place
+func writeChecklistOrder(ctx context.Context, tx pgx.Tx, ordered []int) error {
+	now := time.Now()
+	for i, id := range ordered {
+		if _, err := tx.Exec(ctx, `
+			UPDATE task_checklist_item
+			SET position = $2, updated_at = $3
+			WHERE id = $1 AND position <> $2`, id, i, now); err != nil {
+			return fmt.Errorf("failed to reorder checklist items: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *checklistRepository) Delete(ctx context.Context, taskID int, uid uuid.UUID) error {
+	query := `
+		UPDATE task_checklist_item
+		SET is_active = false, updated_at = $3
+		WHERE task_id = $1 AND item_uid = $2 AND is_active = true`
+
+	result, err := r.db.Exec(ctx, query, taskID, uid, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to delete checklist item: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("checklist item not found")
+	}
+
+	return nil
+}
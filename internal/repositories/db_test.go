@@ -0,0 +1,230 @@
+package repositories
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"lucid-lists-backend/internal/models"
+)
+
+// testDB connects to the database named by TEST_DATABASE_URL, skipping the test when it is unset.
+// The database must have db_schema.sql and every file in migrations/ applied.
+func testDB(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	pool, err := pgxpool.New(context.Background(), dsn)
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+	if err := pool.Ping(context.Background()); err != nil {
+		pool.Close()
+		t.Fatalf("failed to ping test database: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	return pool
+}
+
+// dbFixture creates rows through the repositories under test and removes every project it created,
+// with all of its lists, tasks and task children, when the test ends
+type dbFixture struct {
+	t        *testing.T
+	ctx      context.Context
+	db       *pgxpool.Pool
+	projects ProjectRepository
+	lists    ListRepository
+	tasks    TaskRepository
+}
+
+func newDBFixture(t *testing.T) *dbFixture {
+	db := testDB(t)
+	return &dbFixture{
+		t:        t,
+		ctx:      context.Background(),
+		db:       db,
+		projects: NewProjectRepository(db),
+		lists:    NewListRepository(db),
+		tasks:    NewTaskRepository(db),
+	}
+}
+
+func (f *dbFixture) addProject(name string) *models.Project {
+	f.t.Helper()
+
+	project := &models.Project{
+		ProjectUID: uuid.New(),
+		Name:       name + " " + uuid.NewString(),
+		Status:     "active",
+		Color:      "#3B82F6",
+	}
+	if err := f.projects.Create(f.ctx, project); err != nil {
+		f.t.Fatalf("failed to create project: %v", err)
+	}
+	f.t.Cleanup(func() { f.deleteProject(project.ID) })
+
+	return project
+}
+
+func (f *dbFixture) addList(project *models.Project, name string, position int) *models.List {
+	f.t.Helper()
+
+	list := &models.List{
+		ListUID:   uuid.New(),
+		ProjectID: project.ID,
+		Name:      name,
+		Color:     "#E2E8F0",
+		Position:  position,
+	}
+	if err := f.lists.Create(f.ctx, list); err != nil {
+		f.t.Fatalf("failed to create list: %v", err)
+	}
+
+	return list
+}
+
+func (f *dbFixture) addTask(list *models.List, title string, position *int) *models.Task {
+	f.t.Helper()
+
+	task := &models.Task{
+		TaskUID:  uuid.New(),
+		ListID:   list.ID,
+		Title:    title,
+		Status:   "todo",
+		Color:    "#FFFFFF",
+		Position: position,
+	}
+	if err := f.tasks.Create(f.ctx, task); err != nil {
+		f.t.Fatalf("failed to create task: %v", err)
+	}
+
+	return task
+}
+
+func (f *dbFixture) addChecklistItem(task *models.Task, title string) *models.ChecklistItem {
+	f.t.Helper()
+
+	item := &models.ChecklistItem{ItemUID: uuid.New(), TaskID: task.ID, Title: title}
+	if err := NewChecklistRepository(f.db).Create(f.ctx, item, nil); err != nil {
+		f.t.Fatalf("failed to create checklist item: %v", err)
+	}
+
+	return item
+}
+
+func (f *dbFixture) addLink(task *models.Task, url string) *models.TaskLink {
+	f.t.Helper()
+
+	link := &models.TaskLink{LinkUID: uuid.New(), TaskID: task.ID, URL: url}
+	if err := NewLinkRepository(f.db).Create(f.ctx, link); err != nil {
+		f.t.Fatalf("failed to create link: %v", err)
+	}
+
+	return link
+}
+
+func (f *dbFixture) addDependency(task, dependsOn *models.Task) {
+	f.t.Helper()
+
+	if err := NewDependencyRepository(f.db).Add(f.ctx, task.ID, dependsOn.ID); err != nil {
+		f.t.Fatalf("failed to add dependency: %v", err)
+	}
+}
+
+// count runs a COUNT query and returns its result
+func (f *dbFixture) count(query string, args ...interface{}) int {
+	f.t.Helper()
+
+	var n int
+	if err := f.db.QueryRow(f.ctx, query, args...).Scan(&n); err != nil {
+		f.t.Fatalf("failed to run %q: %v", query, err)
+	}
+
+	return n
+}
+
+// exec runs a statement that sets up state the repositories have no method for
+func (f *dbFixture) exec(query string, args ...interface{}) {
+	f.t.Helper()
+
+	if _, err := f.db.Exec(f.ctx, query, args...); err != nil {
+		f.t.Fatalf("failed to run %q: %v", query, err)
+	}
+}
+
+func (f *dbFixture) deleteProject(projectID int) {
+	taskIDs := `SELECT t.id FROM task t JOIN list l ON l.id = t.list_id WHERE l.project_id = $1`
+	statements := []string{
+		`DELETE FROM task_history WHERE task_id IN (` + taskIDs + `)`,
+		`DELETE FROM task_checklist_item WHERE task_id IN (` + taskIDs + `)`,
+		`DELETE FROM task_link WHERE task_id IN (` + taskIDs + `)`,
+		`DELETE FROM task_dependency WHERE task_id IN (` + taskIDs + `) OR depends_on_task_id IN (` + taskIDs + `)`,
+		`DELETE FROM task WHERE list_id IN (SELECT id FROM list WHERE project_id = $1)`,
+		`DELETE FROM list WHERE project_id = $1`,
+		`DELETE FROM project_task_defaults WHERE project_id = $1`,
+		`DELETE FROM project_state_snapshot WHERE project_id = $1`,
+		`DELETE FROM project_progress_snapshot WHERE project_id = $1`,
+		`DELETE FROM project WHERE id = $1`,
+	}
+
+	for _, statement := range statements {
+		if _, err := f.db.Exec(context.Background(), statement, projectID); err != nil {
+			f.t.Errorf("failed to clean up project %d: %v", projectID, err)
+			return
+		}
+	}
+}
+
+// taskPositions maps the titles of a list's active tasks to their positions
+func (f *dbFixture) taskPositions(list *models.List) map[string]int {
+	f.t.Helper()
+
+	rows, err := f.db.Query(f.ctx, `
+		SELECT title, position
+		FROM task
+		WHERE list_id = $1 AND is_active = true`, list.ID)
+	if err != nil {
+		f.t.Fatalf("failed to read task positions: %v", err)
+	}
+	defer rows.Close()
+
+	positions := map[string]int{}
+	for rows.Next() {
+		var title string
+		var position int
+		if err := rows.Scan(&title, &position); err != nil {
+			f.t.Fatalf("failed to scan task position: %v", err)
+		}
+		positions[title] = position
+	}
+	if err := rows.Err(); err != nil {
+		f.t.Fatalf("failed to read task positions: %v", err)
+	}
+
+	return positions
+}
+
+// listed reports whether GetAll returns the project
+func (f *dbFixture) listed(project *models.Project, includeArchived bool) bool {
+	f.t.Helper()
+
+	projects, err := f.projects.GetAll(f.ctx, includeArchived)
+	if err != nil {
+		f.t.Fatalf("GetAll: %v", err)
+	}
+	for _, p := range projects {
+		if p.ProjectUID == project.ProjectUID {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,156 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type dependencyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewDependencyRepository(db *pgxpool.Pool) DependencyRepository {
+	return &dependencyRepository{db: db}
+}
+
+// Add records that taskID depends on dependsOnID. Both tasks must belong to the same project,
+// and the new edge may not close a cycle. Adds within a project are serialized on the project
+// row: with b -> c and d -> a in place, concurrently adding a -> b and c -> d closes a cycle
+// that neither cycle check can see, and the two adds share no task row to lock. Since every
+// edge stays inside one project, that lock is enough, and other projects are not held up.
+func (r *dependencyRepository) Add(ctx context.Context, taskID, dependsOnID int) error {
+	if taskID == dependsOnID {
+		return fmt.Errorf("task cannot depend on itself")
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var projectID, dependsOnProjectID int
+	err = tx.QueryRow(ctx, `
+		SELECT la.project_id, lb.project_id
+		FROM task a
+		JOIN list la ON la.id = a.list_id
+		JOIN task b ON b.id = $2
+		JOIN list lb ON lb.id = b.list_id
+		WHERE a.id = $1`, taskID, dependsOnID).Scan(&projectID, &dependsOnProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to check task projects: %w", err)
+	}
+	if projectID != dependsOnProjectID {
+		return fmt.Errorf("tasks are in different projects")
+	}
+
+	// NO KEY UPDATE conflicts with itself but not with the key share locks taken by inserts
+	// that reference the project, so lists can still be added while the lock is held
+	if _, err := tx.Exec(ctx, `SELECT id FROM project WHERE id = $1 FOR NO KEY UPDATE`, projectID); err != nil {
+		return fmt.Errorf("failed to lock project dependencies: %w", err)
+	}
+
+	var exists bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM task_dependency WHERE task_id = $1 AND depends_on_task_id = $2
+		)`, taskID, dependsOnID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check dependency: %w", err)
+	}
+	if exists {
+		return fmt.Errorf("dependency already exists")
+	}
+
+	// Adding task -> dependsOn closes a cycle if dependsOn already reaches task
+	var cycle bool
+	err = tx.QueryRow(ctx, `
+		WITH RECURSIVE reachable(id) AS (
+			SELECT depends_on_task_id FROM task_dependency WHERE task_id = $1
+			UNION
+			SELECT d.depends_on_task_id
+			FROM task_dependency d
+			JOIN reachable r ON d.task_id = r.id
+		)
+		SELECT EXISTS (SELECT 1 FROM reachable WHERE id = $2)`, dependsOnID, taskID).Scan(&cycle)
+	if err != nil {
+		return fmt.Errorf("failed to check dependency cycle: %w", err)
+	}
+	if cycle {
+		return fmt.Errorf("dependency cycle")
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO task_dependency (task_id, depends_on_task_id)
+		VALUES ($1, $2)`, taskID, dependsOnID); err != nil {
+		return fmt.Errorf("failed to add dependency: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit dependency: %w", err)
+	}
+
+	return nil
+}
+
+func (r *dependencyRepository) Remove(ctx context.Context, taskID, dependsOnID int) error {
+	query := `DELETE FROM task_dependency WHERE task_id = $1 AND depends_on_task_id = $2`
+
+	result, err := r.db.Exec(ctx, query, taskID, dependsOnID)
+	if err != nil {
+		return fmt.Errorf("failed to remove dependency: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("dependency not found")
+	}
+
+	return nil
+}
+
+// GetDependencyUIDs returns the UIDs of the active tasks taskID depends on
+func (r *dependencyRepository) GetDependencyUIDs(ctx context.Context, taskID int) ([]uuid.UUID, error) {
+	query := `
+		SELECT t.task_uid
+		FROM task_dependency d
+		JOIN task t ON t.id = d.depends_on_task_id
+		WHERE d.task_id = $1 AND t.is_active = true
+		ORDER BY d.created_at, t.id`
+
+	rows, err := r.db.Query(ctx, query, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dependencies: %w", err)
+	}
+	defer rows.Close()
+
+	var uids []uuid.UUID
+	for rows.Next() {
+		var uid uuid.UUID
+		if err := rows.Scan(&uid); err != nil {
+			return nil, fmt.Errorf("failed to scan dependency: %w", err)
+		}
+		uids = append(uids, uid)
+	}
+
+	return uids, nil
+}
+
+// CountIncomplete returns how many of the active tasks taskID depends on are not yet complete
+func (r *dependencyRepository) CountIncomplete(ctx context.Context, taskID int) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM task_dependency d
+		JOIN task t ON t.id = d.depends_on_task_id
+		WHERE d.task_id = $1 AND t.is_active = true
+		  AND t.is_completed = false AND t.status <> 'completed'`
+
+	var count int
+	if err := r.db.QueryRow(ctx, query, taskID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count incomplete dependencies: %w", err)
+	}
+
+	return count, nil
+}
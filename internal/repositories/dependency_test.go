@@ -0,0 +1,85 @@
+package repositories
+
+import "testing"
+
+func TestAddDependencyRejections(t *testing.T) {
+	f := newDBFixture(t)
+	project := f.addProject("Dependencies")
+	todo := f.addList(project, "To do", 0)
+	a := f.addTask(todo, "A", intPtr(1))
+	b := f.addTask(todo, "B", intPtr(2))
+	c := f.addTask(todo, "C", intPtr(3))
+	f.addDependency(a, b)
+	f.addDependency(b, c)
+
+	other := f.addProject("Other")
+	elsewhere := f.addTask(f.addList(other, "To do", 0), "Elsewhere", intPtr(1))
+
+	dependencies := NewDependencyRepository(f.db)
+	tests := []struct {
+		name      string
+		taskID    int
+		dependsOn int
+		want      string
+	}{
+		{"itself", a.ID, a.ID, "task cannot depend on itself"},
+		{"another project", a.ID, elsewhere.ID, "tasks are in different projects"},
+		{"duplicate", a.ID, b.ID, "dependency already exists"},
+		{"direct cycle", b.ID, a.ID, "dependency cycle"},
+		{"cycle through the chain", c.ID, a.ID, "dependency cycle"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := dependencies.Add(f.ctx, tt.taskID, tt.dependsOn)
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("expected %q, got %v", tt.want, err)
+			}
+		})
+	}
+
+	if n := f.count(`SELECT COUNT(*) FROM task_dependency WHERE task_id IN ($1, $2, $3)`, a.ID, b.ID, c.ID); n != 2 {
+		t.Errorf("expected only the two original dependencies, got %d", n)
+	}
+
+	// A dependency that skips ahead along the chain is not a cycle
+	if err := dependencies.Add(f.ctx, a.ID, c.ID); err != nil {
+		t.Errorf("Add: %v", err)
+	}
+}
+
+func TestAddDependencyConcurrentAddsCannotCloseACycle(t *testing.T) {
+	f := newDBFixture(t)
+	project := f.addProject("Dependencies")
+	todo := f.addList(project, "To do", 0)
+	a := f.addTask(todo, "A", intPtr(1))
+	b := f.addTask(todo, "B", intPtr(2))
+	c := f.addTask(todo, "C", intPtr(3))
+	d := f.addTask(todo, "D", intPtr(4))
+	f.addDependency(b, c)
+	f.addDependency(d, a)
+
+	// a -> b -> c -> d -> a: each add is fine alone, but not both
+	dependencies := NewDependencyRepository(f.db)
+	errs := make(chan error, 2)
+	for _, pair := range [][2]int{{a.ID, b.ID}, {c.ID, d.ID}} {
+		go func(pair [2]int) {
+			errs <- dependencies.Add(f.ctx, pair[0], pair[1])
+		}(pair)
+	}
+
+	var cycles int
+	for i := 0; i < 2; i++ {
+		err := <-errs
+		switch {
+		case err == nil:
+		case err.Error() == "dependency cycle":
+			cycles++
+		default:
+			t.Errorf("Add: %v", err)
+		}
+	}
+	if cycles != 1 {
+		t.Errorf("expected exactly one add to be rejected as a cycle, got %d", cycles)
+	}
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -10,14 +11,25 @@ import (
 
 // ProjectRepository defines the interface for project data operations
 type ProjectRepository interface {
-	GetAll(ctx context.Context) ([]models.Project, error)
+	GetAll(ctx context.Context, includeArchived bool) ([]models.Project, error)
 	GetByUID(ctx context.Context, uid uuid.UUID) (*models.Project, error)
-	GetWithLists(ctx context.Context, uid uuid.UUID) (*models.ProjectWithListsResponse, error)
+	GetWithLists(ctx context.Context, uid uuid.UUID, includeArchived bool) (*models.ProjectWithListsResponse, error)
 	Create(ctx context.Context, project *models.Project) error
+	CreateWithContents(ctx context.Context, project *models.Project, lists []models.ListWithTasks) error
 	Update(ctx context.Context, uid uuid.UUID, project *models.Project) error
 	PartialUpdate(ctx context.Context, uid uuid.UUID, updates models.ProjectUpdateRequest) error
 	Delete(ctx context.Context, uid uuid.UUID) error
+	Restore(ctx context.Context, uid uuid.UUID) error
+	SetArchived(ctx context.Context, uid uuid.UUID, archived bool) error
+	UpdatePositions(ctx context.Context, positions map[uuid.UUID]int) error
 	GetMaxPositionByWorkspace(ctx context.Context, workspaceID int) (int, error)
+	CountByStatus(ctx context.Context) (map[string]int, error)
+	GetCounts(ctx context.Context) (map[int]models.ProjectCounts, error)
+	CountByName(ctx context.Context, name string) (int, error)
+	GetColorUsage(ctx context.Context, projectID int) ([]models.ColorUsageResponse, error)
+	GetIntegrityReport(ctx context.Context, projectID int) (*models.IntegrityReportResponse, error)
+	RepairOrphans(ctx context.Context, projectID int) (*models.IntegrityRepairResponse, error)
+	ReorderBoard(ctx context.Context, projectID int, lists []models.BoardReorderList) error
 }
 
 // ListRepository defines the interface for list data operations
@@ -25,11 +37,13 @@ type ListRepository interface {
 	GetByProjectID(ctx context.Context, projectID int) ([]models.List, error)
 	GetByUID(ctx context.Context, uid uuid.UUID) (*models.List, error)
 	Create(ctx context.Context, list *models.List) error
+	CreateBatch(ctx context.Context, lists []models.List) error
 	Update(ctx context.Context, uid uuid.UUID, list *models.List) error
 	PartialUpdate(ctx context.Context, uid uuid.UUID, updates models.ListUpdateRequest) error
 	Delete(ctx context.Context, uid uuid.UUID) error
 	UpdatePosition(ctx context.Context, uid uuid.UUID, position int) error
 	GetMaxPositionByProject(ctx context.Context, projectID int) (int, error)
+	GetSummariesByProjectID(ctx context.Context, projectID int) ([]models.ListSummaryResponse, error)
 }
 
 // TaskRepository defines the interface for task data operations
@@ -37,10 +51,78 @@ type TaskRepository interface {
 	GetByListID(ctx context.Context, listID int) ([]models.Task, error)
 	GetByUID(ctx context.Context, uid uuid.UUID) (*models.Task, error)
 	Create(ctx context.Context, task *models.Task) error
+	CreateBatch(ctx context.Context, tasks []models.Task) error
 	Update(ctx context.Context, uid uuid.UUID, task *models.Task) error
 	PartialUpdate(ctx context.Context, uid uuid.UUID, updates models.TaskUpdateRequest) error
+	UpdateFieldBulk(ctx context.Context, uids []uuid.UUID, field string, value string) ([]models.TaskFieldChange, error)
 	Delete(ctx context.Context, uid uuid.UUID) error
-	MoveToList(ctx context.Context, uid uuid.UUID, newListID int) error
+	MoveToList(ctx context.Context, uid uuid.UUID, newListID int, position *int) error
 	GetByProjectID(ctx context.Context, projectID int) ([]models.Task, error)
 	GetMaxPositionByList(ctx context.Context, listID int) (int, error)
+	ArchiveCompletedByProject(ctx context.Context, projectID int) (int, error)
+	GetByProjectDueBetween(ctx context.Context, projectID int, start, end time.Time) ([]models.Task, error)
+	Query(ctx context.Context, projectID int, filter models.TaskFilter) ([]models.Task, error)
+	GetOverdueByProject(ctx context.Context, projectID int, before time.Time) ([]models.Task, error)
+	GetFlatByProject(ctx context.Context, projectID int) ([]models.FlatTaskResponse, error)
+	GetDailyCountsByList(ctx context.Context, listID int, since time.Time) ([]models.DailyTaskCounts, error)
+	GetDueDatesByProject(ctx context.Context, projectID int, start, end time.Time) ([]time.Time, error)
+	GetOverdueGroupedByProject(ctx context.Context, now time.Time, tasksPerProject int) ([]models.ProjectOverdueResponse, error)
+}
+
+// TaskDefaultsRepository defines the interface for per-project task default operations
+type TaskDefaultsRepository interface {
+	GetByProjectID(ctx context.Context, projectID int) (*models.ProjectTaskDefaults, error)
+	Upsert(ctx context.Context, defaults *models.ProjectTaskDefaults) error
+}
+
+// ProgressSnapshotRepository defines the interface for project progress snapshot operations
+type ProgressSnapshotRepository interface {
+	RecordForAllProjects(ctx context.Context, date time.Time) (int, error)
+	GetByProjectID(ctx context.Context, projectID int, since time.Time) ([]models.ProjectProgressSnapshot, error)
+}
+
+// ProjectStateRepository defines the interface for project state snapshot operations
+type ProjectStateRepository interface {
+	Create(ctx context.Context, snapshot *models.ProjectStateSnapshot, keep int) error
+	GetByProjectID(ctx context.Context, projectID int) ([]models.ProjectStateSnapshot, error)
+	GetByUID(ctx context.Context, projectID int, uid uuid.UUID) (*models.ProjectStateSnapshot, error)
+	Restore(ctx context.Context, projectID int, state *models.ProjectState) error
+}
+
+// TaskHistoryRepository defines the interface for task change history operations
+type TaskHistoryRepository interface {
+	Record(ctx context.Context, taskID int, entries []models.TaskHistory, keep int) error
+	GetByTaskID(ctx context.Context, taskID int) ([]models.TaskHistory, error)
+	GetRecentByProject(ctx context.Context, projectID int, limit int) ([]models.TaskActivity, error)
+}
+
+// ChecklistRepository defines the interface for task checklist item operations
+type ChecklistRepository interface {
+	GetByTaskID(ctx context.Context, taskID int) ([]models.ChecklistItem, error)
+	GetByUID(ctx context.Context, taskID int, uid uuid.UUID) (*models.ChecklistItem, error)
+	Create(ctx context.Context, item *models.ChecklistItem, position *int) error
+	Update(ctx context.Context, item *models.ChecklistItem) error
+	MoveTo(ctx context.Context, taskID int, itemID int, position int) error
+	Delete(ctx context.Context, taskID int, uid uuid.UUID) error
+}
+
+// LinkRepository defines the interface for task link operations
+type LinkRepository interface {
+	GetByTaskID(ctx context.Context, taskID int) ([]models.TaskLink, error)
+	Create(ctx context.Context, link *models.TaskLink) error
+	Delete(ctx context.Context, taskID int, uid uuid.UUID) error
+}
+
+// DependencyRepository defines the interface for task dependency operations
+type DependencyRepository interface {
+	Add(ctx context.Context, taskID, dependsOnID int) error
+	Remove(ctx context.Context, taskID, dependsOnID int) error
+	GetDependencyUIDs(ctx context.Context, taskID int) ([]uuid.UUID, error)
+	CountIncomplete(ctx context.Context, taskID int) (int, error)
+}
+
+// TemplateRepository defines the interface for project template data operations
+type TemplateRepository interface {
+	GetAll(ctx context.Context) ([]models.ProjectTemplate, error)
+	GetByUID(ctx context.Context, uid uuid.UUID) (*models.ProjectTemplate, error)
 }
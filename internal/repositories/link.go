@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"lucid-lists-backend/internal/models"
+)
+
+type linkRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewLinkRepository(db *pgxpool.Pool) LinkRepository {
+	return &linkRepository{db: db}
+}
+
+func (r *linkRepository) GetByTaskID(ctx context.Context, taskID int) ([]models.TaskLink, error) {
+	query := `
+		SELECT id, link_uid, task_id, url, title, created_at, created_by, is_active
+		FROM task_link
+		WHERE task_id = $1 AND is_active = true
+		ORDER BY created_at ASC, id ASC`
+
+	rows, err := r.db.Query(ctx, query, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query task links: %w", err)
+	}
+	defer rows.Close()
+
+	var links []models.TaskLink
+	for rows.Next() {
+		var l models.TaskLink
+		err := rows.Scan(&l.ID, &l.LinkUID, &l.TaskID, &l.URL, &l.Title, &l.CreatedAt, &l.CreatedBy, &l.IsActive)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task link: %w", err)
+		}
+		links = append(links, l)
+	}
+
+	return links, nil
+}
+
+func (r *linkRepository) Create(ctx context.Context, link *models.TaskLink) error {
+	query := `
+		INSERT INTO task_link (link_uid, task_id, url, title, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at`
+
+	err := r.db.QueryRow(ctx, query, link.LinkUID, link.TaskID, link.URL, link.Title, link.CreatedBy).
+		Scan(&link.ID, &link.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create task link: %w", err)
+	}
+
+	link.IsActive = true
+	return nil
+}
+
+func (r *linkRepository) Delete(ctx context.Context, taskID int, uid uuid.UUID) error {
+	query := `
+		UPDATE task_link
+		SET is_active = false
+		WHERE task_id = $1 AND link_uid = $2 AND is_active = true`
+
+	result, err := r.db.Exec(ctx, query, taskID, uid)
+	if err != nil {
+		return fmt.Errorf("failed to delete task link: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("task link not found")
+	}
+
+	return nil
+}
@@ -2,12 +2,13 @@ package repositories
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"lucid-lists-backend/internal/models"
@@ -65,7 +66,7 @@ func (r *listRepository) GetByUID(ctx context.Context, uid uuid.UUID) (*models.L
 	)
 
 	if err != nil {
-		if err == sql.ErrNoRows {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, fmt.Errorf("list not found")
 		}
 		return nil, fmt.Errorf("failed to get list: %w", err)
@@ -74,13 +75,13 @@ func (r *listRepository) GetByUID(ctx context.Context, uid uuid.UUID) (*models.L
 	return &l, nil
 }
 
-func (r *listRepository) Create(ctx context.Context, list *models.List) error {
-	query := `
+const insertListQuery = `
 		INSERT INTO list (list_uid, project_id, name, color, position, created_by)
 		VALUES ($1, $2, $3, $4, $5, $6)
 		RETURNING id, created_at`
 
-	err := r.db.QueryRow(ctx, query,
+func (r *listRepository) Create(ctx context.Context, list *models.List) error {
+	err := r.db.QueryRow(ctx, insertListQuery,
 		list.ListUID, list.ProjectID, list.Name, list.Color, list.Position, list.CreatedBy,
 	).Scan(&list.ID, &list.CreatedAt)
 
@@ -91,6 +92,31 @@ func (r *listRepository) Create(ctx context.Context, list *models.List) error {
 	return nil
 }
 
+// CreateBatch inserts all lists in a single transaction, writing generated IDs and timestamps back into the slice
+func (r *listRepository) CreateBatch(ctx context.Context, lists []models.List) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for i := range lists {
+		list := &lists[i]
+		err := tx.QueryRow(ctx, insertListQuery,
+			list.ListUID, list.ProjectID, list.Name, list.Color, list.Position, list.CreatedBy,
+		).Scan(&list.ID, &list.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create list: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit lists: %w", err)
+	}
+
+	return nil
+}
+
 func (r *listRepository) Update(ctx context.Context, uid uuid.UUID, list *models.List) error {
 	query := `
 		UPDATE list 
@@ -209,3 +235,34 @@ func (r *listRepository) GetMaxPositionByProject(ctx context.Context, projectID
 
 	return maxPosition, nil
 }
+
+// GetSummariesByProjectID returns each active list with its task counts, skipping archived tasks
+func (r *listRepository) GetSummariesByProjectID(ctx context.Context, projectID int) ([]models.ListSummaryResponse, error) {
+	query := `
+		SELECT l.list_uid, l.name, l.color, l.position,
+			   COUNT(t.id),
+			   COUNT(t.id) FILTER (WHERE t.is_completed = true)
+		FROM list l
+		LEFT JOIN task t ON t.list_id = l.id AND t.is_active = true AND t.archived_at IS NULL
+		WHERE l.project_id = $1 AND l.is_active = true
+		GROUP BY l.id
+		ORDER BY l.position`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query list summaries: %w", err)
+	}
+	defer rows.Close()
+
+	summaries := []models.ListSummaryResponse{}
+	for rows.Next() {
+		var s models.ListSummaryResponse
+		err := rows.Scan(&s.ListUID, &s.Name, &s.Color, &s.Position, &s.TotalTasks, &s.CompletedTasks)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan list summary: %w", err)
+		}
+		summaries = append(summaries, s)
+	}
+
+	return summaries, nil
+}
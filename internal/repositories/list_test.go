@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"reflect"
+	"testing"
+
+	"lucid-lists-backend/internal/models"
+)
+
+func TestGetSummariesByProjectID(t *testing.T) {
+	f := newDBFixture(t)
+	project := f.addProject("Summary")
+	done := f.addList(project, "Done", 1)
+	todo := f.addList(project, "To do", 0)
+	empty := f.addList(project, "Empty", 2)
+	removed := f.addList(project, "Removed", 3)
+	f.exec(`UPDATE list SET is_active = false WHERE id = $1`, removed.ID)
+	f.addTask(removed, "Gone with the list", intPtr(1))
+
+	f.addTask(todo, "Draft", intPtr(1))
+	f.addTask(todo, "Review", intPtr(2))
+	deleted := f.addTask(todo, "Deleted", intPtr(3))
+	f.exec(`UPDATE task SET is_active = false WHERE id = $1`, deleted.ID)
+
+	kickoff := f.addTask(done, "Kickoff", intPtr(1))
+	f.exec(`UPDATE task SET is_completed = true WHERE id = $1`, kickoff.ID)
+	f.addTask(done, "Wrap up", intPtr(2))
+	archived := f.addTask(done, "Archived", intPtr(3))
+	f.exec(`UPDATE task SET is_completed = true, archived_at = NOW() WHERE id = $1`, archived.ID)
+
+	summaries, err := f.lists.GetSummariesByProjectID(f.ctx, project.ID)
+	if err != nil {
+		t.Fatalf("GetSummariesByProjectID: %v", err)
+	}
+
+	want := []models.ListSummaryResponse{
+		{ListUID: todo.ListUID, Name: "To do", Color: todo.Color, Position: 0, TotalTasks: 2, CompletedTasks: 0},
+		{ListUID: done.ListUID, Name: "Done", Color: done.Color, Position: 1, TotalTasks: 2, CompletedTasks: 1},
+		{ListUID: empty.ListUID, Name: "Empty", Color: empty.Color, Position: 2, TotalTasks: 0, CompletedTasks: 0},
+	}
+	if !reflect.DeepEqual(summaries, want) {
+		t.Errorf("GetSummariesByProjectID = %+v, want %+v", summaries, want)
+	}
+}
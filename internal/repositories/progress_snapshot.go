@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"lucid-lists-backend/internal/models"
+)
+
+type progressSnapshotRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewProgressSnapshotRepository(db *pgxpool.Pool) ProgressSnapshotRepository {
+	return &progressSnapshotRepository{db: db}
+}
+
+// RecordForAllProjects writes one snapshot per active project for the given date.
+// Running it again on the same date overwrites that day's counts.
+func (r *progressSnapshotRepository) RecordForAllProjects(ctx context.Context, date time.Time) (int, error) {
+	query := `
+		INSERT INTO project_progress_snapshot (project_id, snapshot_date, total_tasks, completed_tasks)
+		SELECT p.id, $1::date,
+			   COUNT(t.id),
+			   COUNT(t.id) FILTER (WHERE t.is_completed = true)
+		FROM project p
+		LEFT JOIN list l ON l.project_id = p.id AND l.is_active = true
+		LEFT JOIN task t ON t.list_id = l.id AND t.is_active = true
+		WHERE p.is_active = true
+		GROUP BY p.id
+		ON CONFLICT (project_id, snapshot_date)
+		DO UPDATE SET total_tasks = EXCLUDED.total_tasks, completed_tasks = EXCLUDED.completed_tasks`
+
+	result, err := r.db.Exec(ctx, query, date)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record progress snapshots: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+func (r *progressSnapshotRepository) GetByProjectID(ctx context.Context, projectID int, since time.Time) ([]models.ProjectProgressSnapshot, error) {
+	query := `
+		SELECT id, project_id, snapshot_date, total_tasks, completed_tasks, created_at
+		FROM project_progress_snapshot
+		WHERE project_id = $1 AND snapshot_date >= $2::date
+		ORDER BY snapshot_date`
+
+	rows, err := r.db.Query(ctx, query, projectID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query progress snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	var snapshots []models.ProjectProgressSnapshot
+	for rows.Next() {
+		var s models.ProjectProgressSnapshot
+		err := rows.Scan(&s.ID, &s.ProjectID, &s.SnapshotDate, &s.TotalTasks, &s.CompletedTasks, &s.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan progress snapshot: %w", err)
+		}
+		snapshots = append(snapshots, s)
+	}
+
+	return snapshots, nil
+}
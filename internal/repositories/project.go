@@ -2,12 +2,13 @@ package repositories
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"lucid-lists-backend/internal/models"
@@ -21,15 +22,16 @@ func NewProjectRepository(db *pgxpool.Pool) ProjectRepository {
 	return &projectRepository{db: db}
 }
 
-func (r *projectRepository) GetAll(ctx context.Context) ([]models.Project, error) {
+// GetAll returns active projects. Archived projects are left out unless includeArchived is set.
+func (r *projectRepository) GetAll(ctx context.Context, includeArchived bool) ([]models.Project, error) {
 	query := `
-		SELECT id, project_uid, name, description, status, color, position, start_date, end_date,
+		SELECT id, project_uid, name, description, status, color, position, start_date, end_date, archived_at,
 			   created_at, created_by, updated_at, updated_by, is_active
 		FROM project
-		WHERE is_active = true
+		WHERE is_active = true AND ($1 OR archived_at IS NULL)
 		ORDER BY COALESCE(position, 999999), created_at DESC`
 
-	rows, err := r.db.Query(ctx, query)
+	rows, err := r.db.Query(ctx, query, includeArchived)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query projects: %w", err)
 	}
@@ -40,7 +42,7 @@ func (r *projectRepository) GetAll(ctx context.Context) ([]models.Project, error
 		var p models.Project
 		err := rows.Scan(
 			&p.ID, &p.ProjectUID, &p.Name, &p.Description, &p.Status, &p.Color, &p.Position,
-			&p.StartDate, &p.EndDate, &p.CreatedAt, &p.CreatedBy,
+			&p.StartDate, &p.EndDate, &p.ArchivedAt, &p.CreatedAt, &p.CreatedBy,
 			&p.UpdatedAt, &p.UpdatedBy, &p.IsActive,
 		)
 		if err != nil {
@@ -54,7 +56,7 @@ func (r *projectRepository) GetAll(ctx context.Context) ([]models.Project, error
 
 func (r *projectRepository) GetByUID(ctx context.Context, uid uuid.UUID) (*models.Project, error) {
 	query := `
-		SELECT id, project_uid, name, description, status, color, position, start_date, end_date,
+		SELECT id, project_uid, name, description, status, color, position, start_date, end_date, archived_at,
 			   created_at, created_by, updated_at, updated_by, is_active
 		FROM project
 		WHERE project_uid = $1 AND is_active = true`
@@ -62,12 +64,12 @@ func (r *projectRepository) GetByUID(ctx context.Context, uid uuid.UUID) (*model
 	var p models.Project
 	err := r.db.QueryRow(ctx, query, uid).Scan(
 		&p.ID, &p.ProjectUID, &p.Name, &p.Description, &p.Status, &p.Color, &p.Position,
-		&p.StartDate, &p.EndDate, &p.CreatedAt, &p.CreatedBy,
+		&p.StartDate, &p.EndDate, &p.ArchivedAt, &p.CreatedAt, &p.CreatedBy,
 		&p.UpdatedAt, &p.UpdatedBy, &p.IsActive,
 	)
 
 	if err != nil {
-		if err == sql.ErrNoRows {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, fmt.Errorf("project not found")
 		}
 		return nil, fmt.Errorf("failed to get project: %w", err)
@@ -76,7 +78,8 @@ func (r *projectRepository) GetByUID(ctx context.Context, uid uuid.UUID) (*model
 	return &p, nil
 }
 
-func (r *projectRepository) GetWithLists(ctx context.Context, uid uuid.UUID) (*models.ProjectWithListsResponse, error) {
+// GetWithLists returns the project board. Archived tasks are left out unless includeArchived is set.
+func (r *projectRepository) GetWithLists(ctx context.Context, uid uuid.UUID, includeArchived bool) (*models.ProjectWithListsResponse, error) {
 	// First get the project
 	project, err := r.GetByUID(ctx, uid)
 	if err != nil {
@@ -89,15 +92,15 @@ func (r *projectRepository) GetWithLists(ctx context.Context, uid uuid.UUID) (*m
 			l.id, l.list_uid, l.project_id, l.name, l.color, l.position,
 			l.created_at, l.created_by, l.updated_at, l.updated_by, l.is_active,
 			t.id, t.task_uid, t.list_id, t.title, t.description, t.priority, 
-			t.status, t.color, t.position, t.is_completed, t.due_date, t.completed_at,
+			t.status, t.color, t.position, t.is_completed, t.due_date, t.completed_at, t.archived_at, t.recurrence,
 			t.created_at, t.created_by, t.updated_at, t.updated_by, t.is_active
 		FROM list l
-		LEFT JOIN task t ON l.id = t.list_id AND t.is_active = true
+		LEFT JOIN task t ON l.id = t.list_id AND t.is_active = true AND ($2 OR t.archived_at IS NULL)
 		WHERE l.project_id = $1 AND l.is_active = true
 		ORDER BY l.position ASC, COALESCE(t.position, 999999) ASC, t.created_at ASC
 	`
 
-	rows, err := r.db.Query(ctx, query, project.ID)
+	rows, err := r.db.Query(ctx, query, project.ID, includeArchived)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query lists with tasks: %w", err)
 	}
@@ -114,13 +117,13 @@ func (r *projectRepository) GetWithLists(ctx context.Context, uid uuid.UUID) (*m
 		var taskTitle, taskStatus, taskColor, taskCreatedBy, taskUpdatedBy *string
 		var taskPosition *int
 		var taskIsCompleted, taskIsActive *bool
-		var taskDueDate, taskCompletedAt, taskCreatedAt, taskUpdatedAt *time.Time
+		var taskDueDate, taskCompletedAt, taskArchivedAt, taskCreatedAt, taskUpdatedAt *time.Time
 
 		err := rows.Scan(
 			&l.ID, &l.ListUID, &l.ProjectID, &l.Name, &l.Color, &l.Position,
 			&l.CreatedAt, &l.CreatedBy, &l.UpdatedAt, &l.UpdatedBy, &l.IsActive,
 			&taskID, &taskUID, &taskListID, &taskTitle, &t.Description, &t.Priority,
-			&taskStatus, &taskColor, &taskPosition, &taskIsCompleted, &taskDueDate, &taskCompletedAt,
+			&taskStatus, &taskColor, &taskPosition, &taskIsCompleted, &taskDueDate, &taskCompletedAt, &taskArchivedAt, &t.Recurrence,
 			&taskCreatedAt, &taskCreatedBy, &taskUpdatedAt, &taskUpdatedBy, &taskIsActive,
 		)
 		if err != nil {
@@ -156,6 +159,8 @@ func (r *projectRepository) GetWithLists(ctx context.Context, uid uuid.UUID) (*m
 				IsCompleted: safeBoolDeref(taskIsCompleted),
 				DueDate:     taskDueDate,
 				CompletedAt: taskCompletedAt,
+				ArchivedAt:  taskArchivedAt,
+				Recurrence:  t.Recurrence,
 				CreatedAt:   safeTimeDeref(taskCreatedAt),
 				UpdatedAt:   taskUpdatedAt,
 			}
@@ -163,6 +168,26 @@ func (r *projectRepository) GetWithLists(ctx context.Context, uid uuid.UUID) (*m
 		}
 	}
 
+	checklists, err := r.getChecklistsByProject(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	dependencies, err := r.getDependenciesByProject(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	links, err := r.getLinksByProject(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, list := range listsMap {
+		for i := range list.Tasks {
+			list.Tasks[i].Checklist = checklists[list.Tasks[i].TaskUID]
+			list.Tasks[i].DependsOn = dependencies[list.Tasks[i].TaskUID]
+			list.Tasks[i].Links = links[list.Tasks[i].TaskUID]
+		}
+	}
+
 	// Convert map to slice in order
 	var finalLists []models.ListWithTasksResponse
 	for _, listUID := range listOrder {
@@ -179,6 +204,7 @@ func (r *projectRepository) GetWithLists(ctx context.Context, uid uuid.UUID) (*m
 			Position:    project.Position,
 			StartDate:   project.StartDate,
 			EndDate:     project.EndDate,
+			ArchivedAt:  project.ArchivedAt,
 			CreatedAt:   project.CreatedAt,
 			UpdatedAt:   project.UpdatedAt,
 		},
@@ -188,13 +214,101 @@ func (r *projectRepository) GetWithLists(ctx context.Context, uid uuid.UUID) (*m
 	return projectWithLists, nil
 }
 
-func (r *projectRepository) Create(ctx context.Context, project *models.Project) error {
+// getChecklistsByProject returns the active checklist items of every task in the project keyed by task UID, ordered by position
+func (r *projectRepository) getChecklistsByProject(ctx context.Context, projectID int) (map[uuid.UUID][]models.ChecklistItemResponse, error) {
 	query := `
+		SELECT t.task_uid, c.item_uid, c.title, c.is_completed, c.position, c.created_at, c.updated_at
+		FROM task_checklist_item c
+		JOIN task t ON t.id = c.task_id
+		JOIN list l ON l.id = t.list_id
+		WHERE l.project_id = $1 AND c.is_active = true AND t.is_active = true
+		ORDER BY c.position ASC, c.id ASC`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query checklist items: %w", err)
+	}
+	defer rows.Close()
+
+	checklists := make(map[uuid.UUID][]models.ChecklistItemResponse)
+	for rows.Next() {
+		var taskUID uuid.UUID
+		var item models.ChecklistItemResponse
+		err := rows.Scan(&taskUID, &item.ItemUID, &item.Title, &item.IsCompleted, &item.Position, &item.CreatedAt, &item.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan checklist item: %w", err)
+		}
+		checklists[taskUID] = append(checklists[taskUID], item)
+	}
+
+	return checklists, nil
+}
+
+// getDependenciesByProject returns the UIDs each task in the project depends on, keyed by task UID
+func (r *projectRepository) getDependenciesByProject(ctx context.Context, projectID int) (map[uuid.UUID][]uuid.UUID, error) {
+	query := `
+		SELECT t.task_uid, dt.task_uid
+		FROM task_dependency d
+		JOIN task t ON t.id = d.task_id
+		JOIN task dt ON dt.id = d.depends_on_task_id
+		JOIN list l ON l.id = t.list_id
+		WHERE l.project_id = $1 AND t.is_active = true AND dt.is_active = true
+		ORDER BY d.created_at, dt.id`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query task dependencies: %w", err)
+	}
+	defer rows.Close()
+
+	dependencies := make(map[uuid.UUID][]uuid.UUID)
+	for rows.Next() {
+		var taskUID, dependsOnUID uuid.UUID
+		if err := rows.Scan(&taskUID, &dependsOnUID); err != nil {
+			return nil, fmt.Errorf("failed to scan task dependency: %w", err)
+		}
+		dependencies[taskUID] = append(dependencies[taskUID], dependsOnUID)
+	}
+
+	return dependencies, nil
+}
+
+// getLinksByProject returns the active links of every task in the project keyed by task UID, oldest first
+func (r *projectRepository) getLinksByProject(ctx context.Context, projectID int) (map[uuid.UUID][]models.TaskLinkResponse, error) {
+	query := `
+		SELECT t.task_uid, k.link_uid, k.url, k.title, k.created_at
+		FROM task_link k
+		JOIN task t ON t.id = k.task_id
+		JOIN list l ON l.id = t.list_id
+		WHERE l.project_id = $1 AND k.is_active = true AND t.is_active = true
+		ORDER BY k.created_at ASC, k.id ASC`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query task links: %w", err)
+	}
+	defer rows.Close()
+
+	links := make(map[uuid.UUID][]models.TaskLinkResponse)
+	for rows.Next() {
+		var taskUID uuid.UUID
+		var link models.TaskLinkResponse
+		if err := rows.Scan(&taskUID, &link.LinkUID, &link.URL, &link.Title, &link.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan task link: %w", err)
+		}
+		links[taskUID] = append(links[taskUID], link)
+	}
+
+	return links, nil
+}
+
+const insertProjectQuery = `
 		INSERT INTO project (project_uid, name, description, status, color, position, start_date, end_date, created_by)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		RETURNING id, created_at`
 
-	err := r.db.QueryRow(ctx, query,
+func (r *projectRepository) Create(ctx context.Context, project *models.Project) error {
+	err := r.db.QueryRow(ctx, insertProjectQuery,
 		project.ProjectUID, project.Name, project.Description, project.Status,
 		project.Color, project.Position, project.StartDate, project.EndDate, project.CreatedBy,
 	).Scan(&project.ID, &project.CreatedAt)
@@ -206,6 +320,54 @@ func (r *projectRepository) Create(ctx context.Context, project *models.Project)
 	return nil
 }
 
+// CreateWithContents inserts a project together with its lists and tasks in a single transaction.
+// Generated IDs and timestamps are written back into the passed models.
+func (r *projectRepository) CreateWithContents(ctx context.Context, project *models.Project, lists []models.ListWithTasks) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, insertProjectQuery,
+		project.ProjectUID, project.Name, project.Description, project.Status,
+		project.Color, project.Position, project.StartDate, project.EndDate, project.CreatedBy,
+	).Scan(&project.ID, &project.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create project: %w", err)
+	}
+
+	for i := range lists {
+		list := &lists[i].List
+		list.ProjectID = project.ID
+
+		err := tx.QueryRow(ctx, insertListQuery,
+			list.ListUID, list.ProjectID, list.Name, list.Color, list.Position, list.CreatedBy,
+		).Scan(&list.ID, &list.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create list: %w", err)
+		}
+
+		for j := range lists[i].Tasks {
+			task := &lists[i].Tasks[j]
+			task.ListID = list.ID
+
+			err := tx.QueryRow(ctx, insertTaskQuery,
+				task.TaskUID, task.ListID, task.Title, task.Description, task.Priority, task.Status, task.Color, task.Position, task.IsCompleted, task.DueDate, task.Recurrence, task.CreatedBy,
+			).Scan(&task.ID, &task.CreatedAt)
+			if err != nil {
+				return fmt.Errorf("failed to create task: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit project: %w", err)
+	}
+
+	return nil
+}
+
 func (r *projectRepository) Update(ctx context.Context, uid uuid.UUID, project *models.Project) error {
 	query := `
 		UPDATE project 
@@ -324,6 +486,157 @@ func (r *projectRepository) Delete(ctx context.Context, uid uuid.UUID) error {
 	return nil
 }
 
+// Restore reactivates a soft-deleted project. Its lists and tasks were never deactivated, so they return with it.
+func (r *projectRepository) Restore(ctx context.Context, uid uuid.UUID) error {
+	query := `UPDATE project SET is_active = true, updated_at = $2 WHERE project_uid = $1 AND is_active = false`
+
+	now := time.Now()
+	result, err := r.db.Exec(ctx, query, uid, now)
+	if err != nil {
+		return fmt.Errorf("failed to restore project: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("project not found")
+	}
+
+	return nil
+}
+
+// SetArchived archives or unarchives a project. Archiving an already archived project keeps the original timestamp.
+func (r *projectRepository) SetArchived(ctx context.Context, uid uuid.UUID, archived bool) error {
+	query := `
+		UPDATE project
+		SET archived_at = CASE WHEN $2 THEN COALESCE(archived_at, $3) ELSE NULL END,
+			updated_at = $3
+		WHERE project_uid = $1 AND is_active = true`
+
+	now := time.Now()
+	result, err := r.db.Exec(ctx, query, uid, archived, now)
+	if err != nil {
+		return fmt.Errorf("failed to update project archive state: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("project not found")
+	}
+
+	return nil
+}
+
+// UpdatePositions sets the position of every given project in one statement. If any UID does not
+// match an active project, nothing is changed and "project not found" is returned.
+func (r *projectRepository) UpdatePositions(ctx context.Context, positions map[uuid.UUID]int) error {
+	if len(positions) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(positions))
+	args := []interface{}{time.Now()}
+	argCount := 2
+	for uid, position := range positions {
+		values = append(values, fmt.Sprintf("($%d::uuid, $%d::int)", argCount, argCount+1))
+		args = append(args, uid, position)
+		argCount += 2
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE project p
+		SET position = v.position, updated_at = $1
+		FROM (VALUES %s) AS v(project_uid, position)
+		WHERE p.project_uid = v.project_uid AND p.is_active = true`,
+		strings.Join(values, ", "))
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update project positions: %w", err)
+	}
+
+	if int(result.RowsAffected()) != len(positions) {
+		return fmt.Errorf("project not found")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit project positions: %w", err)
+	}
+
+	return nil
+}
+
+func (r *projectRepository) CountByStatus(ctx context.Context) (map[string]int, error) {
+	query := `
+		SELECT status, COUNT(*)
+		FROM project
+		WHERE is_active = true
+		GROUP BY status`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count projects by status: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var status string
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan project status count: %w", err)
+		}
+		counts[status] = count
+	}
+
+	return counts, nil
+}
+
+// GetCounts returns active list and unarchived task counts for every active project, keyed by project ID
+func (r *projectRepository) GetCounts(ctx context.Context) (map[int]models.ProjectCounts, error) {
+	query := `
+		SELECT p.id, COUNT(DISTINCT l.id), COUNT(t.id)
+		FROM project p
+		LEFT JOIN list l ON l.project_id = p.id AND l.is_active = true
+		LEFT JOIN task t ON t.list_id = l.id AND t.is_active = true AND t.archived_at IS NULL
+		WHERE p.is_active = true
+		GROUP BY p.id`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count project contents: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[int]models.ProjectCounts)
+	for rows.Next() {
+		var projectID int
+		var c models.ProjectCounts
+		if err := rows.Scan(&projectID, &c.ListCount, &c.TaskCount); err != nil {
+			return nil, fmt.Errorf("failed to scan project counts: %w", err)
+		}
+		counts[projectID] = c
+	}
+
+	return counts, nil
+}
+
+// CountByName counts active projects whose name matches case-insensitively
+func (r *projectRepository) CountByName(ctx context.Context, name string) (int, error) {
+	query := `SELECT COUNT(*) FROM project WHERE LOWER(name) = LOWER($1) AND is_active = true`
+
+	var count int
+	err := r.db.QueryRow(ctx, query, name).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count projects by name: %w", err)
+	}
+
+	return count, nil
+}
+
 // Helper functions
 func safeStringDeref(s *string) string {
 	if s != nil {
@@ -345,3 +658,303 @@ func safeTimeDeref(t *time.Time) time.Time {
 	}
 	return time.Time{}
 }
+
+// GetColorUsage returns each distinct color used by the project's active lists and unarchived tasks,
+// most used first. Colors are compared case-insensitively and reported in upper case.
+func (r *projectRepository) GetColorUsage(ctx context.Context, projectID int) ([]models.ColorUsageResponse, error) {
+	query := `
+		SELECT color, SUM(list_count) + SUM(task_count), SUM(list_count), SUM(task_count)
+		FROM (
+			SELECT UPPER(l.color) AS color, 1 AS list_count, 0 AS task_count
+			FROM list l
+			WHERE l.project_id = $1 AND l.is_active = true
+			UNION ALL
+			SELECT UPPER(t.color) AS color, 0 AS list_count, 1 AS task_count
+			FROM task t
+			INNER JOIN list l ON t.list_id = l.id
+			WHERE l.project_id = $1 AND l.is_active = true AND t.is_active = true AND t.archived_at IS NULL
+		) colors
+		GROUP BY color
+		ORDER BY 2 DESC, color`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query color usage: %w", err)
+	}
+	defer rows.Close()
+
+	usage := []models.ColorUsageResponse{}
+	for rows.Next() {
+		var u models.ColorUsageResponse
+		if err := rows.Scan(&u.Color, &u.Count, &u.ListCount, &u.TaskCount); err != nil {
+			return nil, fmt.Errorf("failed to scan color usage: %w", err)
+		}
+		usage = append(usage, u)
+	}
+
+	return usage, nil
+}
+
+// orphanedTaskCondition matches tasks of a project that are deleted themselves or sit in a deleted list
+const orphanedTaskCondition = `l.project_id = $1 AND (t.is_active = false OR l.is_active = false)`
+
+// GetIntegrityReport finds active rows left behind under deleted lists and tasks
+func (r *projectRepository) GetIntegrityReport(ctx context.Context, projectID int) (*models.IntegrityReportResponse, error) {
+	tasksQuery := `
+		SELECT t.task_uid, t.title, l.list_uid, l.name
+		FROM task t
+		INNER JOIN list l ON t.list_id = l.id
+		WHERE l.project_id = $1 AND t.is_active = true AND l.is_active = false
+		ORDER BY l.position, COALESCE(t.position, 999999), t.created_at`
+
+	rows, err := r.db.Query(ctx, tasksQuery, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query orphaned tasks: %w", err)
+	}
+	defer rows.Close()
+
+	report := &models.IntegrityReportResponse{OrphanedTasks: []models.OrphanedTaskResponse{}}
+	for rows.Next() {
+		var task models.OrphanedTaskResponse
+		if err := rows.Scan(&task.TaskUID, &task.Title, &task.ListUID, &task.ListName); err != nil {
+			return nil, fmt.Errorf("failed to scan orphaned task: %w", err)
+		}
+		report.OrphanedTasks = append(report.OrphanedTasks, task)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read orphaned tasks: %w", err)
+	}
+
+	countsQuery := `
+		SELECT
+			(SELECT COUNT(*)
+			 FROM task_checklist_item c
+			 INNER JOIN task t ON c.task_id = t.id
+			 INNER JOIN list l ON t.list_id = l.id
+			 WHERE c.is_active = true AND ` + orphanedTaskCondition + `),
+			(SELECT COUNT(*)
+			 FROM task_link k
+			 INNER JOIN task t ON k.task_id = t.id
+			 INNER JOIN list l ON t.list_id = l.id
+			 WHERE k.is_active = true AND ` + orphanedTaskCondition + `),
+			(SELECT COUNT(*)
+			 FROM task_dependency d
+			 INNER JOIN task t ON d.task_id = t.id
+			 INNER JOIN list l ON t.list_id = l.id
+			 INNER JOIN task dt ON d.depends_on_task_id = dt.id
+			 INNER JOIN list dl ON dt.list_id = dl.id
+			 WHERE (l.project_id = $1 OR dl.project_id = $1)
+			   AND (t.is_active = false OR l.is_active = false OR dt.is_active = false OR dl.is_active = false))`
+
+	err = r.db.QueryRow(ctx, countsQuery, projectID).Scan(
+		&report.OrphanedChecklistItems, &report.OrphanedLinks, &report.OrphanedDependencies,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count orphaned task data: %w", err)
+	}
+
+	return report, nil
+}
+
+// RepairOrphans soft deletes tasks left in deleted lists, then clears checklist items, links
+// and dependencies hanging off deleted tasks, all in one transaction
+func (r *projectRepository) RepairOrphans(ctx context.Context, projectID int) (*models.IntegrityRepairResponse, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now()
+	result := &models.IntegrityRepairResponse{}
+
+	tasksQuery := `
+		UPDATE task t
+		SET is_active = false, updated_at = $2
+		FROM list l
+		WHERE t.list_id = l.id AND l.project_id = $1 AND t.is_active = true AND l.is_active = false`
+
+	tag, err := tx.Exec(ctx, tasksQuery, projectID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove orphaned tasks: %w", err)
+	}
+	result.TasksRemoved = int(tag.RowsAffected())
+
+	checklistQuery := `
+		UPDATE task_checklist_item c
+		SET is_active = false, updated_at = $2
+		FROM task t
+		INNER JOIN list l ON t.list_id = l.id
+		WHERE c.task_id = t.id AND c.is_active = true AND ` + orphanedTaskCondition
+
+	tag, err = tx.Exec(ctx, checklistQuery, projectID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove orphaned checklist items: %w", err)
+	}
+	result.ChecklistItemsRemoved = int(tag.RowsAffected())
+
+	linksQuery := `
+		UPDATE task_link k
+		SET is_active = false
+		FROM task t
+		INNER JOIN list l ON t.list_id = l.id
+		WHERE k.task_id = t.id AND k.is_active = true AND ` + orphanedTaskCondition
+
+	tag, err = tx.Exec(ctx, linksQuery, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove orphaned links: %w", err)
+	}
+	result.LinksRemoved = int(tag.RowsAffected())
+
+	dependenciesQuery := `
+		DELETE FROM task_dependency d
+		USING task t, list l, task dt, list dl
+		WHERE d.task_id = t.id AND t.list_id = l.id
+		  AND d.depends_on_task_id = dt.id AND dt.list_id = dl.id
+		  AND (l.project_id = $1 OR dl.project_id = $1)
+		  AND (t.is_active = false OR l.is_active = false OR dt.is_active = false OR dl.is_active = false)`
+
+	tag, err = tx.Exec(ctx, dependenciesQuery, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove orphaned dependencies: %w", err)
+	}
+	result.DependenciesRemoved = int(tag.RowsAffected())
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit integrity repair: %w", err)
+	}
+
+	return result, nil
+}
+
+// ReorderBoard places the given tasks into the given lists in order, numbering positions from 1.
+// Tasks already in a listed list but not named (archived ones, for example) keep their relative
+// order after the named tasks. Every list and task must be active and belong to the project.
+func (r *projectRepository) ReorderBoard(ctx context.Context, projectID int, lists []models.BoardReorderList) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	listUIDs := make([]uuid.UUID, 0, len(lists))
+	taskUIDs := []uuid.UUID{}
+	for _, list := range lists {
+		listUIDs = append(listUIDs, list.ListUID)
+		taskUIDs = append(taskUIDs, list.TaskUIDs...)
+	}
+
+	// Lock the lists in id order before any task, as MoveToList does
+	listQuery := `
+		SELECT id, list_uid FROM list
+		WHERE project_id = $1 AND is_active = true AND list_uid = ANY($2)
+		ORDER BY id
+		FOR UPDATE`
+
+	listIDs, err := collectIDsByUID(ctx, tx, listQuery, projectID, listUIDs)
+	if err != nil {
+		return fmt.Errorf("failed to query lists: %w", err)
+	}
+	if len(listIDs) != len(listUIDs) {
+		return fmt.Errorf("list not found")
+	}
+
+	taskQuery := `
+		SELECT t.id, t.task_uid FROM task t
+		INNER JOIN list l ON t.list_id = l.id
+		WHERE l.project_id = $1 AND t.is_active = true AND l.is_active = true AND t.task_uid = ANY($2)
+		FOR UPDATE OF t`
+
+	taskIDs, err := collectIDsByUID(ctx, tx, taskQuery, projectID, taskUIDs)
+	if err != nil {
+		return fmt.Errorf("failed to query tasks: %w", err)
+	}
+	if len(taskIDs) != len(taskUIDs) {
+		return fmt.Errorf("task not found")
+	}
+
+	namedIDs := make([]int, 0, len(taskIDs))
+	for _, id := range taskIDs {
+		namedIDs = append(namedIDs, id)
+	}
+
+	remainingQuery := `
+		SELECT id FROM task
+		WHERE list_id = $1 AND is_active = true AND NOT (id = ANY($2))
+		ORDER BY COALESCE(position, 999999), created_at`
+
+	values := []string{}
+	args := []interface{}{time.Now()}
+	argCount := 2
+	for _, list := range lists {
+		listID := listIDs[list.ListUID]
+
+		ordered := make([]int, 0, len(list.TaskUIDs))
+		for _, taskUID := range list.TaskUIDs {
+			ordered = append(ordered, taskIDs[taskUID])
+		}
+
+		rows, err := tx.Query(ctx, remainingQuery, listID, namedIDs)
+		if err != nil {
+			return fmt.Errorf("failed to query list tasks: %w", err)
+		}
+		for rows.Next() {
+			var id int
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan task id: %w", err)
+			}
+			ordered = append(ordered, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to read list tasks: %w", err)
+		}
+
+		for i, id := range ordered {
+			values = append(values, fmt.Sprintf("($%d::int, $%d::int, $%d::int)", argCount, argCount+1, argCount+2))
+			args = append(args, id, listID, i+1)
+			argCount += 3
+		}
+	}
+
+	if len(values) > 0 {
+		updateQuery := fmt.Sprintf(`
+			UPDATE task t
+			SET list_id = v.list_id, position = v.position, updated_at = $1
+			FROM (VALUES %s) AS v(id, list_id, position)
+			WHERE t.id = v.id`,
+			strings.Join(values, ", "))
+
+		if _, err := tx.Exec(ctx, updateQuery, args...); err != nil {
+			return fmt.Errorf("failed to reorder board: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit board reorder: %w", err)
+	}
+
+	return nil
+}
+
+// collectIDsByUID runs a query selecting (id, uid) pairs and returns them keyed by uid
+func collectIDsByUID(ctx context.Context, tx pgx.Tx, query string, args ...interface{}) (map[uuid.UUID]int, error) {
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var id int
+		var uid uuid.UUID
+		if err := rows.Scan(&id, &uid); err != nil {
+			return nil, err
+		}
+		ids[uid] = id
+	}
+
+	return ids, rows.Err()
+}
@@ -0,0 +1,212 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"lucid-lists-backend/internal/models"
+)
+
+type projectStateRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewProjectStateRepository(db *pgxpool.Pool) ProjectStateRepository {
+	return &projectStateRepository{db: db}
+}
+
+// Create stores the snapshot and prunes everything beyond the newest keep snapshots of the project
+func (r *projectStateRepository) Create(ctx context.Context, snapshot *models.ProjectStateSnapshot, keep int) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	insertQuery := `
+		INSERT INTO project_state_snapshot (snapshot_uid, project_id, state, list_count, task_count)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at`
+
+	err = tx.QueryRow(ctx, insertQuery,
+		snapshot.SnapshotUID, snapshot.ProjectID, snapshot.State, snapshot.ListCount, snapshot.TaskCount,
+	).Scan(&snapshot.ID, &snapshot.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create project snapshot: %w", err)
+	}
+
+	pruneQuery := `
+		DELETE FROM project_state_snapshot
+		WHERE project_id = $1 AND id NOT IN (
+			SELECT id FROM project_state_snapshot
+			WHERE project_id = $1
+			ORDER BY created_at DESC, id DESC
+			LIMIT $2
+		)`
+
+	if _, err := tx.Exec(ctx, pruneQuery, snapshot.ProjectID, keep); err != nil {
+		return fmt.Errorf("failed to prune project snapshots: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit project snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// GetByProjectID returns the project's snapshots newest first, without their state
+func (r *projectStateRepository) GetByProjectID(ctx context.Context, projectID int) ([]models.ProjectStateSnapshot, error) {
+	query := `
+		SELECT id, snapshot_uid, project_id, list_count, task_count, created_at
+		FROM project_state_snapshot
+		WHERE project_id = $1
+		ORDER BY created_at DESC, id DESC`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query project snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	var snapshots []models.ProjectStateSnapshot
+	for rows.Next() {
+		var s models.ProjectStateSnapshot
+		if err := rows.Scan(&s.ID, &s.SnapshotUID, &s.ProjectID, &s.ListCount, &s.TaskCount, &s.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan project snapshot: %w", err)
+		}
+		snapshots = append(snapshots, s)
+	}
+
+	return snapshots, nil
+}
+
+func (r *projectStateRepository) GetByUID(ctx context.Context, projectID int, uid uuid.UUID) (*models.ProjectStateSnapshot, error) {
+	query := `
+		SELECT id, snapshot_uid, project_id, state, list_count, task_count, created_at
+		FROM project_state_snapshot
+		WHERE project_id = $1 AND snapshot_uid = $2`
+
+	var s models.ProjectStateSnapshot
+	err := r.db.QueryRow(ctx, query, projectID, uid).Scan(&s.ID, &s.SnapshotUID, &s.ProjectID, &s.State, &s.ListCount, &s.TaskCount, &s.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("snapshot not found")
+		}
+		return nil, fmt.Errorf("failed to get project snapshot: %w", err)
+	}
+
+	return &s, nil
+}
+
+// Restore makes the project's lists and tasks match state in a single transaction.
+// Rows are matched by UID so checklist items, links and dependencies stay attached;
+// rows missing from the state are soft deleted and rows no longer in the database are recreated.
+func (r *projectStateRepository) Restore(ctx context.Context, projectID int, state *models.ProjectState) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now()
+
+	updateListQuery := `
+		UPDATE list
+		SET name = $3, color = $4, position = $5, is_active = true, updated_at = $6
+		WHERE list_uid = $1 AND project_id = $2
+		RETURNING id`
+
+	insertListQuery := `
+		INSERT INTO list (list_uid, project_id, name, color, position)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`
+
+	updateTaskQuery := `
+		UPDATE task t
+		SET list_id = $3, title = $4, description = $5, priority = $6, status = $7, color = $8, position = $9,
+			is_completed = $10, due_date = $11, completed_at = $12, archived_at = $13, recurrence = $14,
+			is_active = true, updated_at = $15
+		FROM list l
+		WHERE t.list_id = l.id AND t.task_uid = $1 AND l.project_id = $2
+		RETURNING t.id`
+
+	insertTaskQuery := `
+		INSERT INTO task (task_uid, list_id, title, description, priority, status, color, position,
+			is_completed, due_date, completed_at, archived_at, recurrence)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id`
+
+	listIDs := []int{}
+	taskIDs := []int{}
+
+	for _, list := range state.Lists {
+		var listID int
+		err := tx.QueryRow(ctx, updateListQuery, list.ListUID, projectID, list.Name, list.Color, list.Position, now).Scan(&listID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = tx.QueryRow(ctx, insertListQuery, list.ListUID, projectID, list.Name, list.Color, list.Position).Scan(&listID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to restore list: %w", err)
+		}
+		listIDs = append(listIDs, listID)
+
+		for _, task := range list.Tasks {
+			var taskID int
+			err := tx.QueryRow(ctx, updateTaskQuery,
+				task.TaskUID, projectID, listID, task.Title, task.Description, task.Priority, task.Status, task.Color, task.Position,
+				task.IsCompleted, task.DueDate, task.CompletedAt, task.ArchivedAt, task.Recurrence, now,
+			).Scan(&taskID)
+			if errors.Is(err, pgx.ErrNoRows) {
+				// The task is gone or now lives in another project, so recreate it here
+				taskUID := task.TaskUID
+				var taken bool
+				if err := tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM task WHERE task_uid = $1)`, taskUID).Scan(&taken); err != nil {
+					return fmt.Errorf("failed to check task uid: %w", err)
+				}
+				if taken {
+					taskUID = uuid.New()
+				}
+				err = tx.QueryRow(ctx, insertTaskQuery,
+					taskUID, listID, task.Title, task.Description, task.Priority, task.Status, task.Color, task.Position,
+					task.IsCompleted, task.DueDate, task.CompletedAt, task.ArchivedAt, task.Recurrence,
+				).Scan(&taskID)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to restore task: %w", err)
+			}
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+
+	deactivateTasksQuery := `
+		UPDATE task t
+		SET is_active = false, updated_at = $3
+		FROM list l
+		WHERE t.list_id = l.id AND l.project_id = $1 AND t.is_active = true AND NOT (t.id = ANY($2))`
+
+	if _, err := tx.Exec(ctx, deactivateTasksQuery, projectID, taskIDs, now); err != nil {
+		return fmt.Errorf("failed to remove tasks missing from snapshot: %w", err)
+	}
+
+	deactivateListsQuery := `
+		UPDATE list
+		SET is_active = false, updated_at = $3
+		WHERE project_id = $1 AND is_active = true AND NOT (id = ANY($2))`
+
+	if _, err := tx.Exec(ctx, deactivateListsQuery, projectID, listIDs, now); err != nil {
+		return fmt.Errorf("failed to remove lists missing from snapshot: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit snapshot restore: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,335 @@
+package repositories
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+)
+
+func TestGetColorUsage(t *testing.T) {
+	f := newDBFixture(t)
+	project := f.addProject("Colors")
+	doing := f.addList(project, "Doing", 0)
+	f.exec(`UPDATE list SET color = '#ff0000' WHERE id = $1`, doing.ID)
+
+	draft := f.addTask(doing, "Draft", intPtr(1))
+	f.exec(`UPDATE task SET color = '#FF0000' WHERE id = $1`, draft.ID)
+	f.addTask(doing, "Review", intPtr(2))
+	archived := f.addTask(doing, "Archived", intPtr(3))
+	f.exec(`UPDATE task SET color = '#00FF00', archived_at = NOW() WHERE id = $1`, archived.ID)
+	deleted := f.addTask(doing, "Deleted", intPtr(4))
+	f.exec(`UPDATE task SET color = '#00FF00', is_active = false WHERE id = $1`, deleted.ID)
+
+	trash := f.addList(project, "Trash", 1)
+	f.exec(`UPDATE list SET color = '#0000FF', is_active = false WHERE id = $1`, trash.ID)
+	f.addTask(trash, "Orphan", intPtr(1))
+
+	usage, err := f.projects.GetColorUsage(f.ctx, project.ID)
+	if err != nil {
+		t.Fatalf("GetColorUsage: %v", err)
+	}
+
+	want := []models.ColorUsageResponse{
+		{Color: "#FF0000", Count: 2, ListCount: 1, TaskCount: 1},
+		{Color: "#FFFFFF", Count: 1, ListCount: 0, TaskCount: 1},
+	}
+	if !reflect.DeepEqual(usage, want) {
+		t.Errorf("GetColorUsage = %+v, want %+v", usage, want)
+	}
+}
+
+func TestIntegrityReportAndRepair(t *testing.T) {
+	f := newDBFixture(t)
+	project := f.addProject("Integrity")
+	doing := f.addList(project, "Doing", 0)
+	removed := f.addList(project, "Removed", 1)
+
+	healthy := f.addTask(doing, "Healthy", intPtr(1))
+	blocked := f.addTask(doing, "Blocked", intPtr(2))
+	deleted := f.addTask(doing, "Deleted", intPtr(3))
+	orphan := f.addTask(removed, "Left behind", intPtr(1))
+	for _, task := range []*models.Task{healthy, deleted, orphan} {
+		f.addChecklistItem(task, "Check "+task.Title)
+		f.addLink(task, "https://example.com/"+task.TaskUID.String())
+	}
+	f.addDependency(blocked, healthy)
+	f.addDependency(healthy, orphan)
+	f.addDependency(deleted, blocked)
+
+	f.exec(`UPDATE task SET is_active = false WHERE id = $1`, deleted.ID)
+	f.exec(`UPDATE list SET is_active = false WHERE id = $1`, removed.ID)
+
+	// Another project's orphans are not ours to report or repair
+	other := f.addProject("Other")
+	otherRemoved := f.addList(other, "Removed", 0)
+	otherOrphan := f.addTask(otherRemoved, "Not ours", intPtr(1))
+	f.addChecklistItem(otherOrphan, "Check")
+	f.exec(`UPDATE list SET is_active = false WHERE id = $1`, otherRemoved.ID)
+
+	report, err := f.projects.GetIntegrityReport(f.ctx, project.ID)
+	if err != nil {
+		t.Fatalf("GetIntegrityReport: %v", err)
+	}
+	want := &models.IntegrityReportResponse{
+		OrphanedTasks: []models.OrphanedTaskResponse{
+			{TaskUID: orphan.TaskUID, Title: "Left behind", ListUID: removed.ListUID, ListName: "Removed"},
+		},
+		OrphanedChecklistItems: 2,
+		OrphanedLinks:          2,
+		OrphanedDependencies:   2,
+	}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("GetIntegrityReport = %+v, want %+v", report, want)
+	}
+
+	result, err := f.projects.RepairOrphans(f.ctx, project.ID)
+	if err != nil {
+		t.Fatalf("RepairOrphans: %v", err)
+	}
+	wantResult := &models.IntegrityRepairResponse{TasksRemoved: 1, ChecklistItemsRemoved: 2, LinksRemoved: 2, DependenciesRemoved: 2}
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("RepairOrphans = %+v, want %+v", result, wantResult)
+	}
+
+	report, err = f.projects.GetIntegrityReport(f.ctx, project.ID)
+	if err != nil {
+		t.Fatalf("GetIntegrityReport: %v", err)
+	}
+	want = &models.IntegrityReportResponse{OrphanedTasks: []models.OrphanedTaskResponse{}}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("expected a clean report after repair, got %+v", report)
+	}
+
+	// The healthy task keeps its checklist, link and remaining dependency
+	if n := f.count(`SELECT COUNT(*) FROM task_checklist_item WHERE task_id = $1 AND is_active = true`, healthy.ID); n != 1 {
+		t.Errorf("expected the healthy task to keep its checklist item, got %d", n)
+	}
+	if n := f.count(`SELECT COUNT(*) FROM task_link WHERE task_id = $1 AND is_active = true`, healthy.ID); n != 1 {
+		t.Errorf("expected the healthy task to keep its link, got %d", n)
+	}
+	if n := f.count(`SELECT COUNT(*) FROM task_dependency WHERE task_id = $1 AND depends_on_task_id = $2`, blocked.ID, healthy.ID); n != 1 {
+		t.Errorf("expected the dependency between healthy tasks to stay, got %d", n)
+	}
+	if n := f.count(`SELECT COUNT(*) FROM task_checklist_item WHERE task_id = $1 AND is_active = true`, otherOrphan.ID); n != 1 {
+		t.Errorf("expected the other project's checklist item to be left alone, got %d", n)
+	}
+}
+
+func TestReorderBoard(t *testing.T) {
+	f := newDBFixture(t)
+	project := f.addProject("Board")
+	todo := f.addList(project, "To do", 0)
+	done := f.addList(project, "Done", 1)
+	backlog := f.addList(project, "Backlog", 2)
+
+	draft := f.addTask(todo, "Draft", intPtr(1))
+	review := f.addTask(todo, "Review", intPtr(2))
+	ship := f.addTask(todo, "Ship", intPtr(3))
+	archived := f.addTask(todo, "Archived", intPtr(4))
+	f.exec(`UPDATE task SET archived_at = NOW() WHERE id = $1`, archived.ID)
+	f.addTask(done, "Kickoff", intPtr(1))
+	idea := f.addTask(backlog, "Idea", intPtr(1))
+
+	err := f.projects.ReorderBoard(f.ctx, project.ID, []models.BoardReorderList{
+		{ListUID: todo.ListUID, TaskUIDs: []uuid.UUID{ship.TaskUID, draft.TaskUID}},
+		{ListUID: done.ListUID, TaskUIDs: []uuid.UUID{review.TaskUID, idea.TaskUID}},
+	})
+	if err != nil {
+		t.Fatalf("ReorderBoard: %v", err)
+	}
+
+	// Tasks a list already held but the request did not name follow the named ones
+	assertPositions(t, f.taskPositions(todo), map[string]int{"Ship": 1, "Draft": 2, "Archived": 3})
+	assertPositions(t, f.taskPositions(done), map[string]int{"Review": 1, "Idea": 2, "Kickoff": 3})
+	assertPositions(t, f.taskPositions(backlog), map[string]int{})
+}
+
+func TestReorderBoardRejectsForeignAndDeletedRows(t *testing.T) {
+	f := newDBFixture(t)
+	project := f.addProject("Board")
+	todo := f.addList(project, "To do", 0)
+	draft := f.addTask(todo, "Draft", intPtr(1))
+	review := f.addTask(todo, "Review", intPtr(2))
+	deleted := f.addTask(todo, "Deleted", intPtr(3))
+	f.exec(`UPDATE task SET is_active = false WHERE id = $1`, deleted.ID)
+
+	other := f.addProject("Other")
+	foreignList := f.addList(other, "Elsewhere", 0)
+	foreignTask := f.addTask(foreignList, "Not ours", intPtr(1))
+
+	tests := []struct {
+		name  string
+		lists []models.BoardReorderList
+		want  string
+	}{
+		{"list from another project", []models.BoardReorderList{{ListUID: foreignList.ListUID}}, "list not found"},
+		{"unknown list", []models.BoardReorderList{{ListUID: uuid.New()}}, "list not found"},
+		{"task from another project", []models.BoardReorderList{{ListUID: todo.ListUID, TaskUIDs: []uuid.UUID{review.TaskUID, foreignTask.TaskUID}}}, "task not found"},
+		{"deleted task", []models.BoardReorderList{{ListUID: todo.ListUID, TaskUIDs: []uuid.UUID{review.TaskUID, deleted.TaskUID}}}, "task not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := f.projects.ReorderBoard(f.ctx, project.ID, tt.lists)
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("expected %q, got %v", tt.want, err)
+			}
+		})
+	}
+
+	assertPositions(t, f.taskPositions(todo), map[string]int{draft.Title: 1, review.Title: 2})
+	assertPositions(t, f.taskPositions(foreignList), map[string]int{foreignTask.Title: 1})
+}
+
+func TestDeleteAndRestore(t *testing.T) {
+	f := newDBFixture(t)
+	project := f.addProject("Restore")
+	todo := f.addList(project, "To do", 0)
+	f.addTask(todo, "Draft", intPtr(1))
+
+	if err := f.projects.Delete(f.ctx, project.ProjectUID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if f.listed(project, false) {
+		t.Error("expected a deleted project to be left out of GetAll")
+	}
+
+	if err := f.projects.Restore(f.ctx, project.ProjectUID); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if !f.listed(project, false) {
+		t.Error("expected a restored project to be listed by GetAll")
+	}
+	assertPositions(t, f.taskPositions(todo), map[string]int{"Draft": 1})
+
+	for name, uid := range map[string]uuid.UUID{"active project": project.ProjectUID, "unknown project": uuid.New()} {
+		t.Run(name, func(t *testing.T) {
+			err := f.projects.Restore(f.ctx, uid)
+			if err == nil || err.Error() != "project not found" {
+				t.Errorf("expected %q, got %v", "project not found", err)
+			}
+		})
+	}
+}
+
+func TestUpdatePositions(t *testing.T) {
+	f := newDBFixture(t)
+	first := f.addProject("First")
+	second := f.addProject("Second")
+	deleted := f.addProject("Deleted")
+	if err := f.projects.Delete(f.ctx, deleted.ProjectUID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if err := f.projects.UpdatePositions(f.ctx, map[uuid.UUID]int{first.ProjectUID: 2, second.ProjectUID: 1}); err != nil {
+		t.Fatalf("UpdatePositions: %v", err)
+	}
+
+	// Any uid that is not an active project fails the whole update
+	for name, uid := range map[string]uuid.UUID{"deleted project": deleted.ProjectUID, "unknown project": uuid.New()} {
+		t.Run(name, func(t *testing.T) {
+			err := f.projects.UpdatePositions(f.ctx, map[uuid.UUID]int{first.ProjectUID: 5, second.ProjectUID: 6, uid: 7})
+			if err == nil || err.Error() != "project not found" {
+				t.Errorf("expected %q, got %v", "project not found", err)
+			}
+		})
+	}
+
+	for project, want := range map[*models.Project]int{first: 2, second: 1} {
+		if got := f.count(`SELECT position FROM project WHERE id = $1`, project.ID); got != want {
+			t.Errorf("project %q: expected position %d, got %d", project.Name, want, got)
+		}
+	}
+	if n := f.count(`SELECT COUNT(*) FROM project WHERE id = $1 AND position IS NOT NULL`, deleted.ID); n != 0 {
+		t.Error("expected the deleted project's position to be left alone")
+	}
+}
+
+func TestGetCounts(t *testing.T) {
+	f := newDBFixture(t)
+	project := f.addProject("Counts")
+	todo := f.addList(project, "To do", 0)
+	f.addTask(todo, "Draft", intPtr(1))
+	f.addTask(todo, "Review", intPtr(2))
+	archived := f.addTask(todo, "Archived", intPtr(3))
+	f.exec(`UPDATE task SET archived_at = NOW() WHERE id = $1`, archived.ID)
+	deleted := f.addTask(todo, "Deleted", intPtr(4))
+	f.exec(`UPDATE task SET is_active = false WHERE id = $1`, deleted.ID)
+	f.addList(project, "Empty", 1)
+	trash := f.addList(project, "Trash", 2)
+	f.addTask(trash, "Orphan", intPtr(1))
+	f.exec(`UPDATE list SET is_active = false WHERE id = $1`, trash.ID)
+
+	empty := f.addProject("Empty")
+	gone := f.addProject("Gone")
+	if err := f.projects.Delete(f.ctx, gone.ProjectUID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	counts, err := f.projects.GetCounts(f.ctx)
+	if err != nil {
+		t.Fatalf("GetCounts: %v", err)
+	}
+
+	if got, want := counts[project.ID], (models.ProjectCounts{ListCount: 2, TaskCount: 2}); got != want {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+	if got, ok := counts[empty.ID]; !ok || got != (models.ProjectCounts{}) {
+		t.Errorf("expected zero counts for an empty project, got %+v (present %v)", got, ok)
+	}
+	if _, ok := counts[gone.ID]; ok {
+		t.Error("expected a deleted project to be left out")
+	}
+}
+
+func TestSetArchived(t *testing.T) {
+	f := newDBFixture(t)
+	project := f.addProject("Archive")
+
+	if err := f.projects.SetArchived(f.ctx, project.ProjectUID, true); err != nil {
+		t.Fatalf("SetArchived: %v", err)
+	}
+	if f.listed(project, false) {
+		t.Error("expected an archived project to be hidden by default")
+	}
+	if !f.listed(project, true) {
+		t.Error("expected an archived project to be listed when archived projects are included")
+	}
+
+	archived, err := f.projects.GetByUID(f.ctx, project.ProjectUID)
+	if err != nil {
+		t.Fatalf("GetByUID: %v", err)
+	}
+	if archived.ArchivedAt == nil {
+		t.Fatal("expected archived_at to be set")
+	}
+
+	// Archiving again keeps the original timestamp
+	if err := f.projects.SetArchived(f.ctx, project.ProjectUID, true); err != nil {
+		t.Fatalf("SetArchived: %v", err)
+	}
+	again, err := f.projects.GetByUID(f.ctx, project.ProjectUID)
+	if err != nil {
+		t.Fatalf("GetByUID: %v", err)
+	}
+	if again.ArchivedAt == nil || !again.ArchivedAt.Equal(*archived.ArchivedAt) {
+		t.Errorf("expected archived_at to stay %v, got %v", *archived.ArchivedAt, again.ArchivedAt)
+	}
+
+	if err := f.projects.SetArchived(f.ctx, project.ProjectUID, false); err != nil {
+		t.Fatalf("SetArchived: %v", err)
+	}
+	if !f.listed(project, false) {
+		t.Error("expected an unarchived project to be listed by default")
+	}
+
+	if err := f.projects.Delete(f.ctx, project.ProjectUID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := f.projects.SetArchived(f.ctx, project.ProjectUID, true); err == nil || err.Error() != "project not found" {
+		t.Errorf("expected %q for a deleted project, got %v", "project not found", err)
+	}
+}
@@ -2,17 +2,44 @@ package repositories
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"lucid-lists-backend/internal/models"
 )
 
+// taskColumns lists the task columns in the order scanTask expects them
+const taskColumns = `id, task_uid, list_id, title, description, priority, status, color, position, is_completed,
+			   due_date, completed_at, archived_at, recurrence, created_at, created_by, updated_at, updated_by, is_active`
+
+// rowScanner is satisfied by both pgx.Row and pgx.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// prefixedTaskColumns returns taskColumns qualified with a table alias
+func prefixedTaskColumns(alias string) string {
+	columns := strings.Split(taskColumns, ",")
+	for i, column := range columns {
+		columns[i] = alias + "." + strings.TrimSpace(column)
+	}
+	return strings.Join(columns, ", ")
+}
+
+func scanTask(row rowScanner, t *models.Task) error {
+	return row.Scan(
+		&t.ID, &t.TaskUID, &t.ListID, &t.Title, &t.Description, &t.Priority, &t.Status, &t.Color, &t.Position, &t.IsCompleted,
+		&t.DueDate, &t.CompletedAt, &t.ArchivedAt, &t.Recurrence, &t.CreatedAt, &t.CreatedBy, &t.UpdatedAt, &t.UpdatedBy, &t.IsActive,
+	)
+}
+
 type taskRepository struct {
 	db *pgxpool.Pool
 }
@@ -23,8 +50,7 @@ func NewTaskRepository(db *pgxpool.Pool) TaskRepository {
 
 func (r *taskRepository) GetByListID(ctx context.Context, listID int) ([]models.Task, error) {
 	query := `
-		SELECT id, task_uid, list_id, title, description, priority, status, color, position, is_completed,
-			   due_date, completed_at, created_at, created_by, updated_at, updated_by, is_active
+		SELECT ` + taskColumns + `
 		FROM task
 		WHERE list_id = $1 AND is_active = true
 		ORDER BY COALESCE(position, 999999), created_at`
@@ -38,12 +64,7 @@ func (r *taskRepository) GetByListID(ctx context.Context, listID int) ([]models.
 	var tasks []models.Task
 	for rows.Next() {
 		var t models.Task
-		err := rows.Scan(
-			&t.ID, &t.TaskUID, &t.ListID, &t.Title, &t.Description, &t.Priority, &t.Status,
-			&t.Color, &t.Position, &t.IsCompleted, &t.DueDate, &t.CompletedAt,
-			&t.CreatedAt, &t.CreatedBy, &t.UpdatedAt, &t.UpdatedBy, &t.IsActive,
-		)
-		if err != nil {
+		if err := scanTask(rows, &t); err != nil {
 			return nil, fmt.Errorf("failed to scan task: %w", err)
 		}
 		tasks = append(tasks, t)
@@ -54,19 +75,15 @@ func (r *taskRepository) GetByListID(ctx context.Context, listID int) ([]models.
 
 func (r *taskRepository) GetByUID(ctx context.Context, uid uuid.UUID) (*models.Task, error) {
 	query := `
-		SELECT id, task_uid, list_id, title, description, priority, status, color, position, is_completed,
-			   due_date, completed_at, created_at, created_by, updated_at, updated_by, is_active
+		SELECT ` + taskColumns + `
 		FROM task
 		WHERE task_uid = $1 AND is_active = true`
 
 	var t models.Task
-	err := r.db.QueryRow(ctx, query, uid).Scan(
-		&t.ID, &t.TaskUID, &t.ListID, &t.Title, &t.Description, &t.Priority, &t.Status, &t.Color, &t.Position, &t.IsCompleted,
-		&t.DueDate, &t.CompletedAt, &t.CreatedAt, &t.CreatedBy, &t.UpdatedAt, &t.UpdatedBy, &t.IsActive,
-	)
+	err := scanTask(r.db.QueryRow(ctx, query, uid), &t)
 
 	if err != nil {
-		if err == sql.ErrNoRows {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, fmt.Errorf("task not found")
 		}
 		return nil, fmt.Errorf("failed to get task: %w", err)
@@ -75,14 +92,14 @@ func (r *taskRepository) GetByUID(ctx context.Context, uid uuid.UUID) (*models.T
 	return &t, nil
 }
 
-func (r *taskRepository) Create(ctx context.Context, task *models.Task) error {
-	query := `
-		INSERT INTO task (task_uid, list_id, title, description, priority, status, color, position, is_completed, due_date, created_by)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+const insertTaskQuery = `
+		INSERT INTO task (task_uid, list_id, title, description, priority, status, color, position, is_completed, due_date, recurrence, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 		RETURNING id, created_at`
 
-	err := r.db.QueryRow(ctx, query,
-		task.TaskUID, task.ListID, task.Title, task.Description, task.Priority, task.Status, task.Color, task.Position, task.IsCompleted, task.DueDate, task.CreatedBy,
+func (r *taskRepository) Create(ctx context.Context, task *models.Task) error {
+	err := r.db.QueryRow(ctx, insertTaskQuery,
+		task.TaskUID, task.ListID, task.Title, task.Description, task.Priority, task.Status, task.Color, task.Position, task.IsCompleted, task.DueDate, task.Recurrence, task.CreatedBy,
 	).Scan(&task.ID, &task.CreatedAt)
 
 	if err != nil {
@@ -92,6 +109,31 @@ func (r *taskRepository) Create(ctx context.Context, task *models.Task) error {
 	return nil
 }
 
+// CreateBatch inserts all tasks in a single transaction, writing generated IDs and timestamps back into the slice
+func (r *taskRepository) CreateBatch(ctx context.Context, tasks []models.Task) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for i := range tasks {
+		task := &tasks[i]
+		err := tx.QueryRow(ctx, insertTaskQuery,
+			task.TaskUID, task.ListID, task.Title, task.Description, task.Priority, task.Status, task.Color, task.Position, task.IsCompleted, task.DueDate, task.Recurrence, task.CreatedBy,
+		).Scan(&task.ID, &task.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create task: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit tasks: %w", err)
+	}
+
+	return nil
+}
+
 func (r *taskRepository) Update(ctx context.Context, uid uuid.UUID, task *models.Task) error {
 	now := time.Now()
 
@@ -108,12 +150,12 @@ func (r *taskRepository) Update(ctx context.Context, uid uuid.UUID, task *models
 	query := `
 		UPDATE task 
 		SET title = $2, description = $3, priority = $4, status = $5, color = $6, position = $7, is_completed = $8,
-			due_date = $9, completed_at = $10, updated_at = $11, updated_by = $12
+			due_date = $9, completed_at = $10, updated_at = $11, updated_by = $12, recurrence = $13
 		WHERE task_uid = $1 AND is_active = true`
 
 	result, err := r.db.Exec(ctx, query,
 		uid, task.Title, task.Description, task.Priority, task.Status, task.Color, task.Position, task.IsCompleted,
-		task.DueDate, completedAt, now, task.UpdatedBy,
+		task.DueDate, completedAt, now, task.UpdatedBy, task.Recurrence,
 	)
 
 	if err != nil {
@@ -142,30 +184,167 @@ func (r *taskRepository) Delete(ctx context.Context, uid uuid.UUID) error {
 	return nil
 }
 
-func (r *taskRepository) MoveToList(ctx context.Context, uid uuid.UUID, newListID int) error {
-	query := `
-		UPDATE task 
-		SET list_id = $2, updated_at = $3
-		WHERE task_uid = $1 AND is_active = true`
+// maxMoveAttempts bounds how often MoveToList starts over when a concurrent move takes the task
+// to another list between reading its list and locking it
+const maxMoveAttempts = 3
+
+// MoveToList moves a task into a list at the given 1-based position, or to the end of the list when position is nil.
+// The source and target lists are renumbered from 1 in their current order, all in one transaction,
+// so positions end up unique and contiguous even if earlier writes left gaps or duplicates.
+func (r *taskRepository) MoveToList(ctx context.Context, uid uuid.UUID, newListID int, position *int) error {
+	for attempt := 1; ; attempt++ {
+		moved, err := r.moveToList(ctx, uid, newListID, position)
+		if err != nil || moved {
+			return err
+		}
+		if attempt == maxMoveAttempts {
+			return fmt.Errorf("failed to move task: it kept moving between lists")
+		}
+	}
+}
 
-	now := time.Now()
-	result, err := r.db.Exec(ctx, query, uid, newListID, now)
+// moveToList makes one attempt at MoveToList. It reports false, without writing anything,
+// when the task left the list it was read from before its row could be locked.
+func (r *taskRepository) moveToList(ctx context.Context, uid uuid.UUID, newListID int, position *int) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
 
+	var taskID, oldListID int
+	err = tx.QueryRow(ctx, `
+		SELECT id, list_id
+		FROM task
+		WHERE task_uid = $1 AND is_active = true`, uid).Scan(&taskID, &oldListID)
 	if err != nil {
-		return fmt.Errorf("failed to move task: %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, fmt.Errorf("task not found")
+		}
+		return false, fmt.Errorf("failed to get task: %w", err)
 	}
 
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("task not found")
+	// Every move locks the list rows first, in id order, and only then any task row.
+	// Two moves in opposite directions between the same lists therefore queue on the
+	// lower list id instead of each holding a lock the other needs.
+	listIDs := []int{newListID}
+	if oldListID != newListID {
+		listIDs = append(listIDs, oldListID)
+	}
+	sort.Ints(listIDs)
+
+	if _, err := tx.Exec(ctx, `
+		SELECT id
+		FROM list
+		WHERE id = ANY($1)
+		ORDER BY id
+		FOR UPDATE`, listIDs); err != nil {
+		return false, fmt.Errorf("failed to lock lists: %w", err)
 	}
 
-	return nil
+	var currentListID int
+	err = tx.QueryRow(ctx, `
+		SELECT list_id
+		FROM task
+		WHERE id = $1 AND is_active = true
+		FOR UPDATE`, taskID).Scan(&currentListID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, fmt.Errorf("task not found")
+		}
+		return false, fmt.Errorf("failed to lock task: %w", err)
+	}
+	if currentListID != oldListID {
+		return false, nil
+	}
+
+	orders := make(map[int][]int, len(listIDs))
+	for _, listID := range listIDs {
+		ids, err := lockListTaskIDs(ctx, tx, listID, taskID)
+		if err != nil {
+			return false, err
+		}
+		orders[listID] = ids
+	}
+	orders[newListID] = insertAtPosition(orders[newListID], taskID, position)
+
+	values := []string{}
+	args := []interface{}{time.Now()}
+	argCount := 2
+	for _, listID := range listIDs {
+		for i, id := range orders[listID] {
+			values = append(values, fmt.Sprintf("($%d::int, $%d::int, $%d::int)", argCount, argCount+1, argCount+2))
+			args = append(args, id, listID, i+1)
+			argCount += 3
+		}
+	}
+
+	updateQuery := fmt.Sprintf(`
+		UPDATE task t
+		SET list_id = v.list_id, position = v.position, updated_at = $1
+		FROM (VALUES %s) AS v(id, list_id, position)
+		WHERE t.id = v.id`,
+		strings.Join(values, ", "))
+
+	if _, err := tx.Exec(ctx, updateQuery, args...); err != nil {
+		return false, fmt.Errorf("failed to move task: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit task move: %w", err)
+	}
+
+	return true, nil
+}
+
+// lockListTaskIDs locks the list's active tasks other than excludeID and returns their ids in display order
+func lockListTaskIDs(ctx context.Context, tx pgx.Tx, listID int, excludeID int) ([]int, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT id
+		FROM task
+		WHERE list_id = $1 AND is_active = true AND id <> $2
+		ORDER BY COALESCE(position, 999999), created_at, id
+		FOR UPDATE`, listID, excludeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query list tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan task id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read list tasks: %w", err)
+	}
+
+	return ids, nil
+}
+
+// insertAtPosition returns ids with id inserted at the 1-based position. A nil position or one
+// past the end appends, and positions below 1 insert at the front.
+func insertAtPosition(ids []int, id int, position *int) []int {
+	index := len(ids)
+	if position != nil && *position-1 < index {
+		index = *position - 1
+		if index < 0 {
+			index = 0
+		}
+	}
+
+	ordered := make([]int, 0, len(ids)+1)
+	ordered = append(ordered, ids[:index]...)
+	ordered = append(ordered, id)
+	return append(ordered, ids[index:]...)
 }
 
 func (r *taskRepository) GetByProjectID(ctx context.Context, projectID int) ([]models.Task, error) {
 	query := `
-		SELECT t.id, t.task_uid, t.list_id, t.title, t.description, t.priority, t.status, t.color, t.position, t.is_completed,
-			   t.due_date, t.completed_at, t.created_at, t.created_by, t.updated_at, t.updated_by, t.is_active
+		SELECT ` + prefixedTaskColumns("t") + `
 		FROM task t
 		INNER JOIN list l ON t.list_id = l.id
 		WHERE l.project_id = $1 AND t.is_active = true AND l.is_active = true
@@ -180,11 +359,7 @@ func (r *taskRepository) GetByProjectID(ctx context.Context, projectID int) ([]m
 	var tasks []models.Task
 	for rows.Next() {
 		var t models.Task
-		err := rows.Scan(
-			&t.ID, &t.TaskUID, &t.ListID, &t.Title, &t.Description, &t.Priority, &t.Status, &t.Color, &t.Position, &t.IsCompleted,
-			&t.DueDate, &t.CompletedAt, &t.CreatedAt, &t.CreatedBy, &t.UpdatedAt, &t.UpdatedBy, &t.IsActive,
-		)
-		if err != nil {
+		if err := scanTask(rows, &t); err != nil {
 			return nil, fmt.Errorf("failed to scan task: %w", err)
 		}
 		tasks = append(tasks, t)
@@ -243,6 +418,11 @@ func (r *taskRepository) PartialUpdate(ctx context.Context, uid uuid.UUID, updat
 		args = append(args, *updates.DueDate)
 		argCount++
 	}
+	if updates.Recurrence != nil {
+		setParts = append(setParts, fmt.Sprintf("recurrence = $%d", argCount))
+		args = append(args, updates.Recurrence)
+		argCount++
+	}
 	if updates.IsCompleted != nil {
 		setParts = append(setParts, fmt.Sprintf("is_completed = $%d", argCount))
 		args = append(args, *updates.IsCompleted)
@@ -285,6 +465,53 @@ func (r *taskRepository) PartialUpdate(ctx context.Context, uid uuid.UUID, updat
 	return nil
 }
 
+// bulkUpdatableTaskFields are the task columns UpdateFieldBulk may write
+var bulkUpdatableTaskFields = map[string]bool{
+	"priority": true,
+	"color":    true,
+}
+
+// UpdateFieldBulk sets one column to the same value on every given active task in a single statement.
+// It returns the tasks that were updated along with the value each held before.
+func (r *taskRepository) UpdateFieldBulk(ctx context.Context, uids []uuid.UUID, field string, value string) ([]models.TaskFieldChange, error) {
+	if !bulkUpdatableTaskFields[field] {
+		return nil, fmt.Errorf("field %s cannot be bulk updated", field)
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE task t
+		SET %[1]s = $2, updated_at = $3
+		FROM (
+			SELECT id, %[1]s AS old_value
+			FROM task
+			WHERE task_uid = ANY($1) AND is_active = true
+			FOR UPDATE
+		) old
+		WHERE t.id = old.id
+		RETURNING t.id, t.task_uid, old.old_value`, field)
+
+	now := time.Now()
+	rows, err := r.db.Query(ctx, query, uids, value, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bulk update tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []models.TaskFieldChange
+	for rows.Next() {
+		var c models.TaskFieldChange
+		if err := rows.Scan(&c.TaskID, &c.TaskUID, &c.OldValue); err != nil {
+			return nil, fmt.Errorf("failed to scan updated task: %w", err)
+		}
+		changes = append(changes, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to bulk update tasks: %w", err)
+	}
+
+	return changes, nil
+}
+
 func (r *taskRepository) GetMaxPositionByList(ctx context.Context, listID int) (int, error) {
 	query := `SELECT COALESCE(MAX(position), 0) FROM task WHERE list_id = $1 AND is_active = true`
 
@@ -296,3 +523,330 @@ func (r *taskRepository) GetMaxPositionByList(ctx context.Context, listID int) (
 
 	return maxPosition, nil
 }
+
+// GetOverdueGroupedByProject returns incomplete tasks due before now, grouped by project.
+// Each group carries the full overdue count but only the most overdue tasksPerProject tasks.
+func (r *taskRepository) GetOverdueGroupedByProject(ctx context.Context, now time.Time, tasksPerProject int) ([]models.ProjectOverdueResponse, error) {
+	query := `
+		SELECT project_uid, project_name, overdue_count,
+			   task_uid, title, description, priority, status, color, position, is_completed,
+			   due_date, completed_at, created_at, updated_at
+		FROM (
+			SELECT p.project_uid, p.name AS project_name,
+				   COUNT(*) OVER (PARTITION BY p.id) AS overdue_count,
+				   ROW_NUMBER() OVER (PARTITION BY p.id ORDER BY t.due_date, t.created_at) AS rn,
+				   t.task_uid, t.title, t.description, t.priority, t.status, t.color, t.position, t.is_completed,
+				   t.due_date, t.completed_at, t.created_at, t.updated_at
+			FROM task t
+			INNER JOIN list l ON t.list_id = l.id
+			INNER JOIN project p ON l.project_id = p.id
+			WHERE t.is_active = true AND l.is_active = true AND p.is_active = true AND p.archived_at IS NULL
+			  AND t.is_completed = false AND t.due_date IS NOT NULL AND t.due_date < $1
+		) overdue
+		WHERE rn <= $2
+		ORDER BY overdue_count DESC, project_name, rn`
+
+	rows, err := r.db.Query(ctx, query, now, tasksPerProject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query overdue tasks: %w", err)
+	}
+	defer rows.Close()
+
+	groups := []models.ProjectOverdueResponse{}
+	groupIndex := make(map[uuid.UUID]int)
+
+	for rows.Next() {
+		var projectUID uuid.UUID
+		var projectName string
+		var overdueCount int
+		var t models.TaskResponse
+		err := rows.Scan(
+			&projectUID, &projectName, &overdueCount,
+			&t.TaskUID, &t.Title, &t.Description, &t.Priority, &t.Status, &t.Color, &t.Position, &t.IsCompleted,
+			&t.DueDate, &t.CompletedAt, &t.CreatedAt, &t.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan overdue task: %w", err)
+		}
+
+		idx, exists := groupIndex[projectUID]
+		if !exists {
+			groups = append(groups, models.ProjectOverdueResponse{
+				ProjectUID:   projectUID,
+				ProjectName:  projectName,
+				OverdueCount: overdueCount,
+				Tasks:        []models.TaskResponse{},
+			})
+			idx = len(groups) - 1
+			groupIndex[projectUID] = idx
+		}
+		groups[idx].Tasks = append(groups[idx].Tasks, t)
+	}
+
+	return groups, nil
+}
+
+// ArchiveCompletedByProject stamps archived_at on every completed, unarchived task in the project
+func (r *taskRepository) ArchiveCompletedByProject(ctx context.Context, projectID int) (int, error) {
+	query := `
+		UPDATE task t
+		SET archived_at = $2, updated_at = $2
+		FROM list l
+		WHERE t.list_id = l.id AND l.project_id = $1
+		  AND t.is_active = true AND l.is_active = true
+		  AND t.is_completed = true AND t.archived_at IS NULL`
+
+	now := time.Now()
+	result, err := r.db.Exec(ctx, query, projectID, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to archive completed tasks: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// GetByProjectDueBetween returns unarchived tasks in the project due within [start, end)
+func (r *taskRepository) GetByProjectDueBetween(ctx context.Context, projectID int, start, end time.Time) ([]models.Task, error) {
+	query := `
+		SELECT ` + prefixedTaskColumns("t") + `
+		FROM task t
+		INNER JOIN list l ON t.list_id = l.id
+		WHERE l.project_id = $1 AND t.is_active = true AND l.is_active = true
+		  AND t.archived_at IS NULL
+		  AND t.due_date >= $2 AND t.due_date < $3
+		ORDER BY t.due_date, l.position, COALESCE(t.position, 999999)`
+
+	rows, err := r.db.Query(ctx, query, projectID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tasks by due date: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []models.Task
+	for rows.Next() {
+		var t models.Task
+		if err := scanTask(rows, &t); err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+		tasks = append(tasks, t)
+	}
+
+	return tasks, nil
+}
+
+// GetDueDatesByProject returns the due dates of unarchived tasks in the project due within [start, end), in ascending order
+func (r *taskRepository) GetDueDatesByProject(ctx context.Context, projectID int, start, end time.Time) ([]time.Time, error) {
+	query := `
+		SELECT t.due_date
+		FROM task t
+		INNER JOIN list l ON t.list_id = l.id
+		WHERE l.project_id = $1 AND t.is_active = true AND l.is_active = true
+		  AND t.archived_at IS NULL
+		  AND t.due_date >= $2 AND t.due_date < $3
+		ORDER BY t.due_date`
+
+	rows, err := r.db.Query(ctx, query, projectID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query task due dates: %w", err)
+	}
+	defer rows.Close()
+
+	var dueDates []time.Time
+	for rows.Next() {
+		var dueDate time.Time
+		if err := rows.Scan(&dueDate); err != nil {
+			return nil, fmt.Errorf("failed to scan task due date: %w", err)
+		}
+		dueDates = append(dueDates, dueDate)
+	}
+
+	return dueDates, nil
+}
+
+// taskSortOrders maps the sort keys accepted by Query to ORDER BY clauses
+var taskSortOrders = map[string]string{
+	"position":   "l.position, COALESCE(t.position, 999999), t.created_at",
+	"due_date":   "t.due_date ASC NULLS LAST, l.position, COALESCE(t.position, 999999)",
+	"priority":   "CASE t.priority WHEN 'high' THEN 0 WHEN 'medium' THEN 1 WHEN 'low' THEN 2 ELSE 3 END, l.position, COALESCE(t.position, 999999)",
+	"created_at": "t.created_at DESC, t.id DESC",
+}
+
+// Query returns the unarchived tasks in the project that match filter, ordered by filter.Sort
+func (r *taskRepository) Query(ctx context.Context, projectID int, filter models.TaskFilter) ([]models.Task, error) {
+	query, args, err := buildTaskQuery(projectID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []models.Task
+	for rows.Next() {
+		var t models.Task
+		if err := scanTask(rows, &t); err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+		tasks = append(tasks, t)
+	}
+
+	return tasks, nil
+}
+
+// buildTaskQuery returns the SQL and arguments Query runs for filter. Filter values are always
+// passed as arguments; only the whitelisted ORDER BY clause is written into the SQL.
+func buildTaskQuery(projectID int, filter models.TaskFilter) (string, []interface{}, error) {
+	orderBy, ok := taskSortOrders[filter.Sort]
+	if !ok {
+		if filter.Sort != "" {
+			return "", nil, fmt.Errorf("unsupported sort key %s", filter.Sort)
+		}
+		orderBy = taskSortOrders["position"]
+	}
+
+	conditions := []string{
+		"l.project_id = $1",
+		"t.is_active = true",
+		"l.is_active = true",
+		"t.archived_at IS NULL",
+	}
+	args := []interface{}{projectID}
+
+	addCondition := func(format string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(format, len(args)))
+	}
+
+	if len(filter.Statuses) > 0 {
+		addCondition("t.status = ANY($%d)", filter.Statuses)
+	}
+	if len(filter.Priorities) > 0 {
+		addCondition("t.priority = ANY($%d)", filter.Priorities)
+	}
+	if filter.IsCompleted != nil {
+		addCondition("t.is_completed = $%d", *filter.IsCompleted)
+	}
+	if filter.DueBefore != nil {
+		addCondition("t.due_date < $%d", *filter.DueBefore)
+	}
+	if filter.DueAfter != nil {
+		addCondition("t.due_date >= $%d", *filter.DueAfter)
+	}
+	if filter.HasDueDate != nil {
+		if *filter.HasDueDate {
+			conditions = append(conditions, "t.due_date IS NOT NULL")
+		} else {
+			conditions = append(conditions, "t.due_date IS NULL")
+		}
+	}
+
+	query := `
+		SELECT ` + prefixedTaskColumns("t") + `
+		FROM task t
+		INNER JOIN list l ON t.list_id = l.id
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY ` + orderBy
+
+	return query, args, nil
+}
+
+// GetOverdueByProject returns the project's incomplete, unarchived tasks due before the given instant, most overdue first
+func (r *taskRepository) GetOverdueByProject(ctx context.Context, projectID int, before time.Time) ([]models.Task, error) {
+	query := `
+		SELECT ` + prefixedTaskColumns("t") + `
+		FROM task t
+		INNER JOIN list l ON t.list_id = l.id
+		WHERE l.project_id = $1 AND t.is_active = true AND l.is_active = true
+		  AND t.archived_at IS NULL AND t.is_completed = false
+		  AND t.due_date IS NOT NULL AND t.due_date < $2
+		ORDER BY t.due_date, t.created_at`
+
+	rows, err := r.db.Query(ctx, query, projectID, before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query overdue tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []models.Task
+	for rows.Next() {
+		var t models.Task
+		if err := scanTask(rows, &t); err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+		tasks = append(tasks, t)
+	}
+
+	return tasks, nil
+}
+
+// GetFlatByProject returns every unarchived task in the project alongside its list name, in board order.
+// Tasks sharing a position keep the order they were created in.
+func (r *taskRepository) GetFlatByProject(ctx context.Context, projectID int) ([]models.FlatTaskResponse, error) {
+	query := `
+		SELECT l.list_uid, l.name, t.task_uid, t.title, t.description, t.priority, t.status,
+			   t.is_completed, t.due_date
+		FROM task t
+		INNER JOIN list l ON t.list_id = l.id
+		WHERE l.project_id = $1 AND t.is_active = true AND l.is_active = true
+		  AND t.archived_at IS NULL
+		ORDER BY l.position ASC, COALESCE(t.position, 999999) ASC, t.created_at ASC, t.id ASC`
+
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tasks: %w", err)
+	}
+	defer rows.Close()
+
+	tasks := []models.FlatTaskResponse{}
+	for rows.Next() {
+		var t models.FlatTaskResponse
+		err := rows.Scan(
+			&t.ListUID, &t.ListName, &t.TaskUID, &t.Title, &t.Description, &t.Priority, &t.Status,
+			&t.IsCompleted, &t.DueDate,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+		tasks = append(tasks, t)
+	}
+
+	return tasks, nil
+}
+
+// GetDailyCountsByList returns per-day created and completed task counts for the list since the given time.
+// Only days with at least one event are returned.
+func (r *taskRepository) GetDailyCountsByList(ctx context.Context, listID int, since time.Time) ([]models.DailyTaskCounts, error) {
+	query := `
+		SELECT day, SUM(created), SUM(completed)
+		FROM (
+			SELECT created_at::date AS day, 1 AS created, 0 AS completed
+			FROM task
+			WHERE list_id = $1 AND is_active = true AND created_at >= $2
+			UNION ALL
+			SELECT completed_at::date AS day, 0 AS created, 1 AS completed
+			FROM task
+			WHERE list_id = $1 AND is_active = true AND completed_at >= $2
+		) events
+		GROUP BY day
+		ORDER BY day`
+
+	rows, err := r.db.Query(ctx, query, listID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query list trend: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []models.DailyTaskCounts
+	for rows.Next() {
+		var c models.DailyTaskCounts
+		if err := rows.Scan(&c.Day, &c.Created, &c.Completed); err != nil {
+			return nil, fmt.Errorf("failed to scan list trend: %w", err)
+		}
+		counts = append(counts, c)
+	}
+
+	return counts, nil
+}
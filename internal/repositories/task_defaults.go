@@ -0,0 +1,55 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"lucid-lists-backend/internal/models"
+)
+
+type taskDefaultsRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTaskDefaultsRepository(db *pgxpool.Pool) TaskDefaultsRepository {
+	return &taskDefaultsRepository{db: db}
+}
+
+func (r *taskDefaultsRepository) GetByProjectID(ctx context.Context, projectID int) (*models.ProjectTaskDefaults, error) {
+	query := `
+		SELECT project_id, priority, status, color, updated_at
+		FROM project_task_defaults
+		WHERE project_id = $1`
+
+	var d models.ProjectTaskDefaults
+	err := r.db.QueryRow(ctx, query, projectID).Scan(&d.ProjectID, &d.Priority, &d.Status, &d.Color, &d.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("task defaults not found")
+		}
+		return nil, fmt.Errorf("failed to get task defaults: %w", err)
+	}
+
+	return &d, nil
+}
+
+// Upsert replaces the project's task defaults, creating the row on first use
+func (r *taskDefaultsRepository) Upsert(ctx context.Context, defaults *models.ProjectTaskDefaults) error {
+	query := `
+		INSERT INTO project_task_defaults (project_id, priority, status, color)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (project_id) DO UPDATE
+		SET priority = EXCLUDED.priority, status = EXCLUDED.status, color = EXCLUDED.color, updated_at = CURRENT_TIMESTAMP
+		RETURNING updated_at`
+
+	err := r.db.QueryRow(ctx, query, defaults.ProjectID, defaults.Priority, defaults.Status, defaults.Color).Scan(&defaults.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save task defaults: %w", err)
+	}
+
+	return nil
+}
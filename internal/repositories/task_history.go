@@ -0,0 +1,116 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"lucid-lists-backend/internal/models"
+)
+
+type taskHistoryRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTaskHistoryRepository(db *pgxpool.Pool) TaskHistoryRepository {
+	return &taskHistoryRepository{db: db}
+}
+
+// Record stores the entries for a task and prunes everything beyond the newest keep entries
+func (r *taskHistoryRepository) Record(ctx context.Context, taskID int, entries []models.TaskHistory, keep int) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	insertQuery := `
+		INSERT INTO task_history (task_id, field, old_value, new_value, changed_by)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	for _, entry := range entries {
+		if _, err := tx.Exec(ctx, insertQuery, taskID, entry.Field, entry.OldValue, entry.NewValue, entry.ChangedBy); err != nil {
+			return fmt.Errorf("failed to record task history: %w", err)
+		}
+	}
+
+	pruneQuery := `
+		DELETE FROM task_history
+		WHERE task_id = $1 AND id NOT IN (
+			SELECT id FROM task_history
+			WHERE task_id = $1
+			ORDER BY changed_at DESC, id DESC
+			LIMIT $2
+		)`
+
+	if _, err := tx.Exec(ctx, pruneQuery, taskID, keep); err != nil {
+		return fmt.Errorf("failed to prune task history: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit task history: %w", err)
+	}
+
+	return nil
+}
+
+func (r *taskHistoryRepository) GetByTaskID(ctx context.Context, taskID int) ([]models.TaskHistory, error) {
+	query := `
+		SELECT id, task_id, field, old_value, new_value, changed_by, changed_at
+		FROM task_history
+		WHERE task_id = $1
+		ORDER BY changed_at DESC, id DESC`
+
+	rows, err := r.db.Query(ctx, query, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query task history: %w", err)
+	}
+	defer rows.Close()
+
+	var history []models.TaskHistory
+	for rows.Next() {
+		var h models.TaskHistory
+		err := rows.Scan(&h.ID, &h.TaskID, &h.Field, &h.OldValue, &h.NewValue, &h.ChangedBy, &h.ChangedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task history: %w", err)
+		}
+		history = append(history, h)
+	}
+
+	return history, nil
+}
+
+// GetRecentByProject returns the newest history entries across all active tasks of a project
+func (r *taskHistoryRepository) GetRecentByProject(ctx context.Context, projectID int, limit int) ([]models.TaskActivity, error) {
+	query := `
+		SELECT h.id, h.task_id, h.field, h.old_value, h.new_value, h.changed_by, h.changed_at, t.task_uid, t.title
+		FROM task_history h
+		INNER JOIN task t ON h.task_id = t.id
+		INNER JOIN list l ON t.list_id = l.id
+		WHERE l.project_id = $1 AND t.is_active = true AND l.is_active = true
+		ORDER BY h.changed_at DESC, h.id DESC
+		LIMIT $2`
+
+	rows, err := r.db.Query(ctx, query, projectID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query project activity: %w", err)
+	}
+	defer rows.Close()
+
+	var activity []models.TaskActivity
+	for rows.Next() {
+		var a models.TaskActivity
+		err := rows.Scan(&a.ID, &a.TaskID, &a.Field, &a.OldValue, &a.NewValue, &a.ChangedBy, &a.ChangedAt, &a.TaskUID, &a.TaskTitle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan project activity: %w", err)
+		}
+		activity = append(activity, a)
+	}
+
+	return activity, nil
+}
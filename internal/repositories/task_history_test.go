@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"lucid-lists-backend/internal/models"
+)
+
+func TestGetRecentByProject(t *testing.T) {
+	f := newDBFixture(t)
+	history := NewTaskHistoryRepository(f.db)
+	project := f.addProject("Activity")
+	doing := f.addList(project, "Doing", 0)
+	removed := f.addList(project, "Removed", 1)
+
+	changedAt := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	record := func(task *models.Task, field string, minutes int) {
+		t.Helper()
+		entry := models.TaskHistory{Field: field, NewValue: &field}
+		if err := history.Record(f.ctx, task.ID, []models.TaskHistory{entry}, 50); err != nil {
+			t.Fatalf("Record: %v", err)
+		}
+		f.exec(`UPDATE task_history SET changed_at = $2 WHERE task_id = $1 AND field = $3`,
+			task.ID, changedAt.Add(time.Duration(minutes)*time.Minute), field)
+	}
+
+	draft := f.addTask(doing, "Draft", intPtr(1))
+	review := f.addTask(doing, "Review", intPtr(2))
+	deleted := f.addTask(doing, "Deleted", intPtr(3))
+	orphan := f.addTask(removed, "Orphan", intPtr(1))
+	other := f.addTask(f.addList(f.addProject("Other"), "Doing", 0), "Elsewhere", intPtr(1))
+
+	record(draft, "title", 1)
+	record(review, "status", 3)
+	record(draft, "priority", 2)
+	record(review, "color", 0)
+	record(deleted, "status", 10)
+	record(orphan, "status", 10)
+	record(other, "status", 10)
+	f.exec(`UPDATE task SET is_active = false WHERE id = $1`, deleted.ID)
+	f.exec(`UPDATE list SET is_active = false WHERE id = $1`, removed.ID)
+
+	activity, err := history.GetRecentByProject(f.ctx, project.ID, 3)
+	if err != nil {
+		t.Fatalf("GetRecentByProject: %v", err)
+	}
+
+	type entry struct{ title, field string }
+	var got []entry
+	for _, a := range activity {
+		if a.TaskUID != draft.TaskUID && a.TaskUID != review.TaskUID {
+			t.Errorf("unexpected task %s in activity", a.TaskTitle)
+		}
+		got = append(got, entry{a.TaskTitle, a.Field})
+	}
+	want := []entry{{"Review", "status"}, {"Draft", "priority"}, {"Draft", "title"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRecentByProject = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,529 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+)
+
+func TestInsertAtPosition(t *testing.T) {
+	position := func(p int) *int { return &p }
+
+	tests := []struct {
+		name     string
+		ids      []int
+		position *int
+		want     []int
+	}{
+		{"append when nil", []int{1, 2, 3}, nil, []int{1, 2, 3, 9}},
+		{"front", []int{1, 2, 3}, position(1), []int{9, 1, 2, 3}},
+		{"middle", []int{1, 2, 3}, position(2), []int{1, 9, 2, 3}},
+		{"last slot", []int{1, 2, 3}, position(4), []int{1, 2, 3, 9}},
+		{"past the end", []int{1, 2, 3}, position(10), []int{1, 2, 3, 9}},
+		{"below one", []int{1, 2, 3}, position(0), []int{9, 1, 2, 3}},
+		{"empty list", nil, position(3), []int{9}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := insertAtPosition(tt.ids, 9, tt.position)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("insertAtPosition(%v, 9, %v) = %v, want %v", tt.ids, tt.position, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInsertAtPositionLeavesInputUntouched(t *testing.T) {
+	ids := make([]int, 3, 8)
+	copy(ids, []int{1, 2, 3})
+	position := 2
+
+	insertAtPosition(ids, 9, &position)
+
+	if !reflect.DeepEqual(ids, []int{1, 2, 3}) {
+		t.Errorf("expected the input to be unchanged, got %v", ids)
+	}
+}
+
+func TestMoveToListWithinList(t *testing.T) {
+	f := newDBFixture(t)
+	list := f.addList(f.addProject("Move"), "Todo", 0)
+	f.addTask(list, "A", intPtr(1))
+	f.addTask(list, "B", intPtr(2))
+	c := f.addTask(list, "C", intPtr(3))
+
+	if err := f.tasks.MoveToList(f.ctx, c.TaskUID, list.ID, intPtr(1)); err != nil {
+		t.Fatalf("MoveToList: %v", err)
+	}
+
+	assertPositions(t, f.taskPositions(list), map[string]int{"C": 1, "A": 2, "B": 3})
+}
+
+func TestMoveToListRenumbersBothLists(t *testing.T) {
+	f := newDBFixture(t)
+	project := f.addProject("Move")
+	todo := f.addList(project, "Todo", 0)
+	done := f.addList(project, "Done", 1)
+
+	// Positions written by older clients may have gaps, duplicates and NULLs
+	f.addTask(todo, "A", intPtr(2))
+	b := f.addTask(todo, "B", intPtr(5))
+	f.addTask(todo, "C", intPtr(5))
+	f.addTask(todo, "D", nil)
+	f.addTask(done, "X", intPtr(3))
+	f.addTask(done, "Y", intPtr(7))
+
+	if err := f.tasks.MoveToList(f.ctx, b.TaskUID, done.ID, intPtr(2)); err != nil {
+		t.Fatalf("MoveToList: %v", err)
+	}
+
+	assertPositions(t, f.taskPositions(todo), map[string]int{"A": 1, "C": 2, "D": 3})
+	assertPositions(t, f.taskPositions(done), map[string]int{"X": 1, "B": 2, "Y": 3})
+}
+
+func TestMoveToListAppendsWithoutPositionOrPastTheEnd(t *testing.T) {
+	f := newDBFixture(t)
+	project := f.addProject("Move")
+	todo := f.addList(project, "Todo", 0)
+	done := f.addList(project, "Done", 1)
+	a := f.addTask(todo, "A", intPtr(1))
+	b := f.addTask(todo, "B", intPtr(2))
+	f.addTask(done, "X", intPtr(1))
+
+	if err := f.tasks.MoveToList(f.ctx, a.TaskUID, done.ID, nil); err != nil {
+		t.Fatalf("MoveToList: %v", err)
+	}
+	if err := f.tasks.MoveToList(f.ctx, b.TaskUID, done.ID, intPtr(10)); err != nil {
+		t.Fatalf("MoveToList: %v", err)
+	}
+
+	assertPositions(t, f.taskPositions(todo), map[string]int{})
+	assertPositions(t, f.taskPositions(done), map[string]int{"X": 1, "A": 2, "B": 3})
+}
+
+func TestMoveToListUnknownTask(t *testing.T) {
+	f := newDBFixture(t)
+	list := f.addList(f.addProject("Move"), "Todo", 0)
+
+	err := f.tasks.MoveToList(f.ctx, uuid.New(), list.ID, nil)
+	if err == nil || err.Error() != "task not found" {
+		t.Errorf("expected task not found, got %v", err)
+	}
+}
+
+func TestMoveToListOppositeMovesDoNotDeadlock(t *testing.T) {
+	f := newDBFixture(t)
+	project := f.addProject("Move")
+	left := f.addList(project, "Left", 0)
+	right := f.addList(project, "Right", 1)
+
+	var leftTasks, rightTasks []*models.Task
+	for i := 1; i <= 5; i++ {
+		leftTasks = append(leftTasks, f.addTask(left, fmt.Sprintf("L%d", i), intPtr(i)))
+		rightTasks = append(rightTasks, f.addTask(right, fmt.Sprintf("R%d", i), intPtr(i)))
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*len(leftTasks))
+	for i := range leftTasks {
+		wg.Add(2)
+		go func(task *models.Task) {
+			defer wg.Done()
+			errs <- f.tasks.MoveToList(f.ctx, task.TaskUID, right.ID, intPtr(1))
+		}(leftTasks[i])
+		go func(task *models.Task) {
+			defer wg.Done()
+			errs <- f.tasks.MoveToList(f.ctx, task.TaskUID, left.ID, intPtr(1))
+		}(rightTasks[i])
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("MoveToList: %v", err)
+		}
+	}
+
+	assertContiguous(t, f.taskPositions(left))
+	assertContiguous(t, f.taskPositions(right))
+}
+
+func intPtr(i int) *int { return &i }
+
+func assertPositions(t *testing.T, got, want map[string]int) {
+	t.Helper()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("positions = %v, want %v", got, want)
+	}
+}
+
+// assertContiguous fails unless the positions run 1..n without repeats
+func assertContiguous(t *testing.T, positions map[string]int) {
+	t.Helper()
+
+	seen := make(map[int]bool, len(positions))
+	for title, position := range positions {
+		if position < 1 || position > len(positions) || seen[position] {
+			t.Errorf("task %s has position %d in %v", title, position, positions)
+		}
+		seen[position] = true
+	}
+}
+
+func TestUpdateFieldBulk(t *testing.T) {
+	f := newDBFixture(t)
+	list := f.addList(f.addProject("Bulk"), "Doing", 0)
+	white := f.addTask(list, "White", intPtr(1))
+	green := f.addTask(list, "Green", intPtr(2))
+	f.exec(`UPDATE task SET color = '#00FF00' WHERE id = $1`, green.ID)
+	deleted := f.addTask(list, "Deleted", intPtr(3))
+	f.exec(`UPDATE task SET is_active = false WHERE id = $1`, deleted.ID)
+
+	changes, err := f.tasks.UpdateFieldBulk(f.ctx, []uuid.UUID{white.TaskUID, green.TaskUID, deleted.TaskUID, uuid.New()}, "color", "#FF0000")
+	if err != nil {
+		t.Fatalf("UpdateFieldBulk: %v", err)
+	}
+
+	oldValues := map[uuid.UUID]string{}
+	for _, change := range changes {
+		if change.OldValue == nil {
+			t.Fatalf("expected an old value for %s", change.TaskUID)
+		}
+		oldValues[change.TaskUID] = *change.OldValue
+	}
+	want := map[uuid.UUID]string{white.TaskUID: "#FFFFFF", green.TaskUID: "#00FF00"}
+	if !reflect.DeepEqual(oldValues, want) {
+		t.Errorf("old values = %v, want %v", oldValues, want)
+	}
+
+	colors := map[int]string{}
+	for _, task := range []*models.Task{white, green, deleted} {
+		var color string
+		if err := f.db.QueryRow(f.ctx, `SELECT color FROM task WHERE id = $1`, task.ID).Scan(&color); err != nil {
+			t.Fatalf("failed to read color: %v", err)
+		}
+		colors[task.ID] = color
+	}
+	if colors[white.ID] != "#FF0000" || colors[green.ID] != "#FF0000" || colors[deleted.ID] != "#FFFFFF" {
+		t.Errorf("unexpected colors after update: %v", colors)
+	}
+}
+
+func TestUpdateFieldBulkRejectsOtherFields(t *testing.T) {
+	repo := NewTaskRepository(nil)
+
+	if _, err := repo.UpdateFieldBulk(context.Background(), []uuid.UUID{uuid.New()}, "title", "x"); err == nil {
+		t.Error("expected title to be rejected")
+	}
+}
+
+func TestBuildTaskQueryDefaults(t *testing.T) {
+	query, args, err := buildTaskQuery(7, models.TaskFilter{})
+	if err != nil {
+		t.Fatalf("buildTaskQuery: %v", err)
+	}
+
+	if !reflect.DeepEqual(args, []interface{}{7}) {
+		t.Errorf("args = %v, want [7]", args)
+	}
+	wantWhere := "WHERE l.project_id = $1 AND t.is_active = true AND l.is_active = true AND t.archived_at IS NULL\n"
+	if !strings.Contains(query, wantWhere) {
+		t.Errorf("expected %q in %s", wantWhere, query)
+	}
+	if !strings.HasSuffix(query, "ORDER BY "+taskSortOrders["position"]) {
+		t.Errorf("expected the position order by default, got %s", query)
+	}
+}
+
+func TestBuildTaskQueryFilters(t *testing.T) {
+	isCompleted, hasDueDate := false, true
+	before := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	after := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	statuses := []string{"todo", "in_progress"}
+	priorities := []string{"high", "low'; DROP TABLE task; --"}
+
+	query, args, err := buildTaskQuery(7, models.TaskFilter{
+		Statuses:    statuses,
+		Priorities:  priorities,
+		IsCompleted: &isCompleted,
+		DueBefore:   &before,
+		DueAfter:    &after,
+		HasDueDate:  &hasDueDate,
+		Sort:        "priority",
+	})
+	if err != nil {
+		t.Fatalf("buildTaskQuery: %v", err)
+	}
+
+	wantArgs := []interface{}{7, statuses, priorities, false, before, after}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args = %v, want %v", args, wantArgs)
+	}
+
+	wantWhere := "WHERE l.project_id = $1 AND t.is_active = true AND l.is_active = true AND t.archived_at IS NULL" +
+		" AND t.status = ANY($2) AND t.priority = ANY($3) AND t.is_completed = $4" +
+		" AND t.due_date < $5 AND t.due_date >= $6 AND t.due_date IS NOT NULL\n"
+	if !strings.Contains(query, wantWhere) {
+		t.Errorf("expected %q in %s", wantWhere, query)
+	}
+	if strings.Contains(query, "DROP") || strings.Contains(query, "todo") {
+		t.Errorf("expected filter values to be passed only as arguments, got %s", query)
+	}
+	if !strings.HasSuffix(query, "ORDER BY "+taskSortOrders["priority"]) {
+		t.Errorf("expected the priority order, got %s", query)
+	}
+}
+
+func TestBuildTaskQueryPlaceholdersFollowPresentFilters(t *testing.T) {
+	hasDueDate := false
+	before := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	query, args, err := buildTaskQuery(7, models.TaskFilter{DueBefore: &before, HasDueDate: &hasDueDate})
+	if err != nil {
+		t.Fatalf("buildTaskQuery: %v", err)
+	}
+
+	if !reflect.DeepEqual(args, []interface{}{7, before}) {
+		t.Errorf("args = %v, want [7 %v]", args, before)
+	}
+	if !strings.Contains(query, "AND t.due_date < $2 AND t.due_date IS NULL\n") {
+		t.Errorf("expected due_before to take $2, got %s", query)
+	}
+}
+
+func TestBuildTaskQuerySortKeys(t *testing.T) {
+	for key, orderBy := range taskSortOrders {
+		query, _, err := buildTaskQuery(7, models.TaskFilter{Sort: key})
+		if err != nil {
+			t.Errorf("%s: %v", key, err)
+			continue
+		}
+		if !strings.HasSuffix(query, "ORDER BY "+orderBy) {
+			t.Errorf("%s: expected ORDER BY %s, got %s", key, orderBy, query)
+		}
+	}
+
+	for _, key := range []string{"title", "t.id; DROP TABLE task", "POSITION"} {
+		if _, _, err := buildTaskQuery(7, models.TaskFilter{Sort: key}); err == nil {
+			t.Errorf("expected sort key %q to be rejected", key)
+		}
+	}
+}
+
+func TestGetOverdueGroupedByProject(t *testing.T) {
+	f := newDBFixture(t)
+	// A date long past keeps overdue tasks from other data in the database out of the way
+	now := time.Date(2001, 1, 10, 12, 0, 0, 0, time.UTC)
+	due := func(task *models.Task, offset time.Duration) {
+		f.exec(`UPDATE task SET due_date = $2 WHERE id = $1`, task.ID, now.Add(offset))
+	}
+
+	busy := f.addProject("Busy")
+	busyList := f.addList(busy, "Todo", 0)
+	due(f.addTask(busyList, "Late 1h", intPtr(1)), -time.Hour)
+	due(f.addTask(busyList, "Late 3h", intPtr(2)), -3*time.Hour)
+	due(f.addTask(busyList, "Late 2h", intPtr(3)), -2*time.Hour)
+	due(f.addTask(busyList, "Upcoming", intPtr(4)), time.Hour)
+	finished := f.addTask(busyList, "Finished late", intPtr(5))
+	due(finished, -48*time.Hour)
+	f.exec(`UPDATE task SET is_completed = true WHERE id = $1`, finished.ID)
+	deleted := f.addTask(busyList, "Deleted", intPtr(6))
+	due(deleted, -5*time.Hour)
+	f.exec(`UPDATE task SET is_active = false WHERE id = $1`, deleted.ID)
+
+	quiet := f.addProject("Quiet")
+	quietList := f.addList(quiet, "Todo", 0)
+	due(f.addTask(quietList, "Slightly late", intPtr(1)), -time.Minute)
+	f.addTask(quietList, "No due date", intPtr(2))
+
+	shelved := f.addProject("Shelved")
+	due(f.addTask(f.addList(shelved, "Todo", 0), "Forgotten", intPtr(1)), -time.Hour)
+	f.exec(`UPDATE project SET archived_at = $2 WHERE id = $1`, shelved.ID, now)
+
+	removed := f.addProject("Removed")
+	due(f.addTask(f.addList(removed, "Todo", 0), "Gone", intPtr(1)), -time.Hour)
+	f.exec(`UPDATE project SET is_active = false WHERE id = $1`, removed.ID)
+
+	groups, err := f.tasks.GetOverdueGroupedByProject(f.ctx, now, 2)
+	if err != nil {
+		t.Fatalf("GetOverdueGroupedByProject: %v", err)
+	}
+
+	type group struct {
+		name   string
+		count  int
+		titles []string
+	}
+	names := map[uuid.UUID]string{busy.ProjectUID: "Busy", quiet.ProjectUID: "Quiet", shelved.ProjectUID: "Shelved", removed.ProjectUID: "Removed"}
+	var got []group
+	for _, g := range groups {
+		name, ours := names[g.ProjectUID]
+		if !ours {
+			continue
+		}
+		var titles []string
+		for _, task := range g.Tasks {
+			titles = append(titles, task.Title)
+		}
+		got = append(got, group{name, g.OverdueCount, titles})
+	}
+
+	want := []group{
+		{"Busy", 3, []string{"Late 3h", "Late 2h"}},
+		{"Quiet", 1, []string{"Slightly late"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetOverdueGroupedByProject = %+v, want %+v", got, want)
+	}
+}
+
+func TestGetDailyCountsByList(t *testing.T) {
+	f := newDBFixture(t)
+	project := f.addProject("Trend")
+	list := f.addList(project, "Doing", 0)
+	other := f.addList(project, "Other", 1)
+	day := func(d, hour int) time.Time { return time.Date(2026, 3, d, hour, 30, 0, 0, time.UTC) }
+	event := func(list *models.List, title string, createdAt time.Time, completedAt *time.Time) {
+		task := f.addTask(list, title, nil)
+		f.exec(`UPDATE task SET created_at = $2, completed_at = $3 WHERE id = $1`, task.ID, createdAt, completedAt)
+	}
+	completed := func(at time.Time) *time.Time { return &at }
+
+	event(list, "Morning", day(8, 9), nil)
+	event(list, "Late night", day(8, 23), completed(day(10, 1)))
+	event(list, "Old", day(1, 12), completed(day(9, 12)))
+	event(list, "Today", day(10, 8), nil)
+	event(other, "Elsewhere", day(9, 12), nil)
+	deleted := f.addTask(list, "Deleted", nil)
+	f.exec(`UPDATE task SET created_at = $2, is_active = false WHERE id = $1`, deleted.ID, day(9, 12))
+
+	counts, err := f.tasks.GetDailyCountsByList(f.ctx, list.ID, time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("GetDailyCountsByList: %v", err)
+	}
+
+	type dayCounts struct {
+		day                string
+		created, completed int
+	}
+	var got []dayCounts
+	for _, c := range counts {
+		got = append(got, dayCounts{c.Day.Format("2006-01-02"), c.Created, c.Completed})
+	}
+	want := []dayCounts{
+		{"2026-03-08", 2, 0},
+		{"2026-03-09", 0, 1},
+		{"2026-03-10", 1, 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetDailyCountsByList = %+v, want %+v", got, want)
+	}
+}
+
+func TestGetFlatByProjectOrder(t *testing.T) {
+	f := newDBFixture(t)
+	project := f.addProject("Flat")
+	later := f.addList(project, "Later", 2)
+	first := f.addList(project, "First", 0)
+	f.addList(project, "Empty", 1)
+	removed := f.addList(project, "Removed", -1)
+	f.addTask(removed, "In a deleted list", intPtr(1))
+	f.exec(`UPDATE list SET is_active = false WHERE id = $1`, removed.ID)
+
+	createdAt := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	add := func(list *models.List, title string, position *int, minutes int) *models.Task {
+		task := f.addTask(list, title, position)
+		f.exec(`UPDATE task SET created_at = $2 WHERE id = $1`, task.ID, createdAt.Add(time.Duration(minutes)*time.Minute))
+		return task
+	}
+
+	add(later, "Z", intPtr(1), 0)
+	add(first, "Unpositioned", nil, 0)
+	add(first, "Tie created later", intPtr(3), 10)
+	add(first, "B", intPtr(2), 0)
+	add(first, "Tie created first", intPtr(3), 5)
+	add(first, "Same instant, inserted first", intPtr(4), 0)
+	add(first, "Same instant, inserted second", intPtr(4), 0)
+	add(first, "A", intPtr(1), 30)
+	archived := add(first, "Archived", intPtr(0), 0)
+	f.exec(`UPDATE task SET archived_at = NOW() WHERE id = $1`, archived.ID)
+	deleted := add(first, "Deleted", intPtr(0), 0)
+	f.exec(`UPDATE task SET is_active = false WHERE id = $1`, deleted.ID)
+
+	tasks, err := f.tasks.GetFlatByProject(f.ctx, project.ID)
+	if err != nil {
+		t.Fatalf("GetFlatByProject: %v", err)
+	}
+
+	var got []string
+	for _, task := range tasks {
+		got = append(got, task.ListName+"/"+task.Title)
+	}
+	want := []string{
+		"First/A",
+		"First/B",
+		"First/Tie created first",
+		"First/Tie created later",
+		"First/Same instant, inserted first",
+		"First/Same instant, inserted second",
+		"First/Unpositioned",
+		"Later/Z",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetFlatByProject order = %v, want %v", got, want)
+	}
+}
+
+func TestGetOverdueByProject(t *testing.T) {
+	f := newDBFixture(t)
+	project := f.addProject("Overdue")
+	todo := f.addList(project, "To do", 0)
+	cutoff := time.Date(2001, 1, 10, 5, 0, 0, 0, time.UTC)
+
+	due := map[string]time.Time{
+		"Last week":   cutoff.Add(-7 * 24 * time.Hour),
+		"Yesterday":   cutoff.Add(-time.Minute),
+		"At cutoff":   cutoff,
+		"Completed":   cutoff.Add(-time.Hour),
+		"Archived":    cutoff.Add(-time.Hour),
+		"Deleted":     cutoff.Add(-time.Hour),
+		"Later today": cutoff.Add(time.Hour),
+	}
+	for title, dueDate := range due {
+		task := f.addTask(todo, title, nil)
+		f.exec(`UPDATE task SET due_date = $2 WHERE id = $1`, task.ID, dueDate)
+		switch title {
+		case "Completed":
+			f.exec(`UPDATE task SET is_completed = true WHERE id = $1`, task.ID)
+		case "Archived":
+			f.exec(`UPDATE task SET archived_at = NOW() WHERE id = $1`, task.ID)
+		case "Deleted":
+			f.exec(`UPDATE task SET is_active = false WHERE id = $1`, task.ID)
+		}
+	}
+	f.addTask(todo, "No due date", nil)
+
+	tasks, err := f.tasks.GetOverdueByProject(f.ctx, project.ID, cutoff)
+	if err != nil {
+		t.Fatalf("GetOverdueByProject: %v", err)
+	}
+
+	var titles []string
+	for _, task := range tasks {
+		titles = append(titles, task.Title)
+	}
+	if want := []string{"Last week", "Yesterday"}; !reflect.DeepEqual(titles, want) {
+		t.Errorf("expected %v, got %v", want, titles)
+	}
+}
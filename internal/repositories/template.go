@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"lucid-lists-backend/internal/models"
+)
+
+type templateRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTemplateRepository(db *pgxpool.Pool) TemplateRepository {
+	return &templateRepository{db: db}
+}
+
+func (r *templateRepository) GetAll(ctx context.Context) ([]models.ProjectTemplate, error) {
+	query := `
+		SELECT id, template_uid, name, description, body, created_at, is_active
+		FROM project_template
+		WHERE is_active = true
+		ORDER BY name`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []models.ProjectTemplate
+	for rows.Next() {
+		var t models.ProjectTemplate
+		err := rows.Scan(&t.ID, &t.TemplateUID, &t.Name, &t.Description, &t.Body, &t.CreatedAt, &t.IsActive)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan template: %w", err)
+		}
+		templates = append(templates, t)
+	}
+
+	return templates, nil
+}
+
+func (r *templateRepository) GetByUID(ctx context.Context, uid uuid.UUID) (*models.ProjectTemplate, error) {
+	query := `
+		SELECT id, template_uid, name, description, body, created_at, is_active
+		FROM project_template
+		WHERE template_uid = $1 AND is_active = true`
+
+	var t models.ProjectTemplate
+	err := r.db.QueryRow(ctx, query, uid).Scan(&t.ID, &t.TemplateUID, &t.Name, &t.Description, &t.Body, &t.CreatedAt, &t.IsActive)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("template not found")
+		}
+		return nil, fmt.Errorf("failed to get template: %w", err)
+	}
+
+	return &t, nil
+}
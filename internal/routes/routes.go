@@ -12,7 +12,7 @@ import (
 )
 
 // SetupRoutes configures all the routes for the application
-func SetupRoutes(r *gin.Engine, projectHandler *handlers.ProjectHandler, listHandler *handlers.ListHandler, taskHandler *handlers.TaskHandler) {
+func SetupRoutes(r *gin.Engine, projectHandler *handlers.ProjectHandler, listHandler *handlers.ListHandler, taskHandler *handlers.TaskHandler, templateHandler *handlers.TemplateHandler, metaHandler *handlers.MetaHandler) {
 	// Add middleware
 	r.Use(middleware.RequestLogging())
 
@@ -31,17 +31,54 @@ func SetupRoutes(r *gin.Engine, projectHandler *handlers.ProjectHandler, listHan
 		// Log API group initialization
 		logger.WithComponent("router").Info("Initializing API routes")
 
+		// API metadata
+		api.GET("/meta", metaHandler.GetMeta)
+
+		// Server clock and the same instant in the requested timezone
+		api.GET("/time", metaHandler.GetTime)
+
 		// Project routes
 		projects := api.Group("/projects")
 		{
 			projects.GET("", projectHandler.GetProjects)
+			projects.GET("/status-counts", projectHandler.GetStatusCounts)
+			projects.GET("/name-available", projectHandler.CheckNameAvailable)
 			projects.GET("/:uid", projectHandler.GetProject)
 			projects.POST("", projectHandler.CreateProject)
+			projects.POST("/from-template", projectHandler.CreateFromTemplate)
+			projects.POST("/reorder", projectHandler.ReorderProjects)
 			projects.PUT("/:uid", projectHandler.UpdateProject)
 			projects.PATCH("/:uid", projectHandler.PartialUpdateProject)
 			projects.DELETE("/:uid", projectHandler.DeleteProject)
+			projects.PATCH("/:uid/archive", projectHandler.SetArchived)
+			projects.POST("/:uid/restore", projectHandler.RestoreProject)
+			projects.POST("/:uid/clone", projectHandler.CloneProject)
+			projects.GET("/:uid/colors", projectHandler.GetColorUsage)
+			projects.GET("/:uid/board-summary", projectHandler.GetBoardSummary)
+			projects.PUT("/:uid/board/reorder", projectHandler.ReorderBoard)
+			projects.GET("/:uid/progress/history", projectHandler.GetProgressHistory)
+			projects.GET("/:uid/report", projectHandler.GetProjectReport)
+			projects.GET("/:uid/integrity", projectHandler.GetIntegrityReport)
+			projects.POST("/:uid/integrity/repair", projectHandler.RepairIntegrity)
+			projects.GET("/:uid/snapshots", projectHandler.GetSnapshots)
+			projects.POST("/:uid/snapshots", projectHandler.CreateSnapshot)
+			projects.POST("/:uid/snapshots/:snapshotUid/restore", projectHandler.RestoreSnapshot)
+			projects.POST("/:uid/tasks/archive-completed", projectHandler.ArchiveCompletedTasks)
+			projects.POST("/:uid/lists/bulk", listHandler.CreateListsBulk)
+			projects.GET("/:uid/lists/:listUid/trend", listHandler.GetListTrend)
+			projects.GET("/:uid/task-defaults", projectHandler.GetTaskDefaults)
+			projects.PUT("/:uid/task-defaults", projectHandler.UpdateTaskDefaults)
+			projects.GET("/:uid/tasks", projectHandler.QueryTasks)
+			projects.GET("/:uid/tasks/calendar", projectHandler.GetTaskCalendar)
+			projects.GET("/:uid/tasks/due-days", projectHandler.GetDueDays)
+			projects.GET("/:uid/tasks/overdue", projectHandler.GetOverdueTasks)
+			projects.GET("/:uid/tasks/flat", projectHandler.GetFlatTasks)
+			projects.GET("/:uid/tasks/no-due-date", projectHandler.GetTasksWithoutDueDate)
 		}
 
+		// Template routes
+		api.GET("/templates", templateHandler.GetTemplates)
+
 		// List routes
 		lists := api.Group("/lists")
 		{
@@ -50,16 +87,30 @@ func SetupRoutes(r *gin.Engine, projectHandler *handlers.ProjectHandler, listHan
 			lists.PATCH("/:uid", listHandler.PartialUpdateList)
 			lists.DELETE("/:uid", listHandler.DeleteList)
 			lists.PUT("/:uid/position", listHandler.UpdatePosition)
+			lists.POST("/:uid/tasks/bulk", taskHandler.CreateTasksBulk)
 		}
 
 		// Task routes
 		tasks := api.Group("/tasks")
 		{
 			tasks.POST("", taskHandler.CreateTask)
+			tasks.GET("/overdue/by-project", taskHandler.GetOverdueByProject)
+			tasks.POST("/bulk-priority", taskHandler.BulkUpdatePriority)
+			tasks.POST("/bulk-color", taskHandler.BulkUpdateColor)
 			tasks.PUT("/:uid", taskHandler.UpdateTask)
 			tasks.PATCH("/:uid", taskHandler.PartialUpdateTask)
 			tasks.DELETE("/:uid", taskHandler.DeleteTask)
 			tasks.POST("/:uid/move", taskHandler.MoveTask)
+			tasks.GET("/:uid/history", taskHandler.GetTaskHistory)
+			tasks.GET("/:uid/checklist", taskHandler.GetChecklist)
+			tasks.POST("/:uid/checklist", taskHandler.AddChecklistItem)
+			tasks.PATCH("/:uid/checklist/:itemUid", taskHandler.UpdateChecklistItem)
+			tasks.DELETE("/:uid/checklist/:itemUid", taskHandler.DeleteChecklistItem)
+			tasks.GET("/:uid/links", taskHandler.GetLinks)
+			tasks.POST("/:uid/links", taskHandler.AddLink)
+			tasks.DELETE("/:uid/links/:linkUid", taskHandler.RemoveLink)
+			tasks.POST("/:uid/dependencies", taskHandler.AddDependency)
+			tasks.DELETE("/:uid/dependencies/:dependsOnUid", taskHandler.RemoveDependency)
 		}
 	}
 
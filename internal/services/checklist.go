@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/internal/utils"
+)
+
+// GetChecklist returns a task's checklist items ordered by position
+func (s *TaskService) GetChecklist(ctx context.Context, taskUID uuid.UUID) ([]models.ChecklistItemResponse, error) {
+	task, err := s.getTaskForChecklist(ctx, taskUID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.checklistRepo.GetByTaskID(ctx, task.ID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get checklist")
+	}
+
+	response := []models.ChecklistItemResponse{}
+	for i := range items {
+		response = append(response, newChecklistItemResponse(&items[i]))
+	}
+
+	return response, nil
+}
+
+// AddChecklistItem appends an item to a task's checklist, or inserts it at the requested position
+func (s *TaskService) AddChecklistItem(ctx context.Context, taskUID uuid.UUID, req *models.ChecklistItemRequest) (*models.ChecklistItemResponse, error) {
+	task, err := s.getTaskForChecklist(ctx, taskUID)
+	if err != nil {
+		return nil, err
+	}
+
+	item := &models.ChecklistItem{
+		ItemUID: uuid.New(),
+		TaskID:  task.ID,
+		Title:   req.Title,
+	}
+	if req.IsCompleted != nil {
+		item.IsCompleted = *req.IsCompleted
+	}
+
+	if err := s.checklistRepo.Create(ctx, item, req.Position); err != nil {
+		return nil, utils.NewInternalError("Failed to create checklist item")
+	}
+
+	response := newChecklistItemResponse(item)
+	return &response, nil
+}
+
+// UpdateChecklistItem renames, toggles, or repositions a checklist item
+func (s *TaskService) UpdateChecklistItem(ctx context.Context, taskUID, itemUID uuid.UUID, req *models.ChecklistItemUpdateRequest) (*models.ChecklistItemResponse, error) {
+	task, err := s.getTaskForChecklist(ctx, taskUID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.checklistRepo.GetByUID(ctx, task.ID, itemUID)
+	if err != nil {
+		if err.Error() == "checklist item not found" {
+			return nil, utils.NewNotFoundError("Checklist item not found")
+		}
+		return nil, utils.NewInternalError("Failed to get checklist item")
+	}
+
+	if req.Title != nil || req.IsCompleted != nil {
+		if req.Title != nil {
+			item.Title = *req.Title
+		}
+		if req.IsCompleted != nil {
+			item.IsCompleted = *req.IsCompleted
+		}
+
+		if err := s.checklistRepo.Update(ctx, item); err != nil {
+			if err.Error() == "checklist item not found" {
+				return nil, utils.NewNotFoundError("Checklist item not found")
+			}
+			return nil, utils.NewInternalError("Failed to update checklist item")
+		}
+	}
+
+	if req.Position != nil && *req.Position != item.Position {
+		if err := s.checklistRepo.MoveTo(ctx, task.ID, item.ID, *req.Position); err != nil {
+			return nil, utils.NewInternalError("Failed to reorder checklist")
+		}
+	}
+
+	updated, err := s.checklistRepo.GetByUID(ctx, task.ID, itemUID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get updated checklist item")
+	}
+
+	response := newChecklistItemResponse(updated)
+	return &response, nil
+}
+
+// DeleteChecklistItem removes an item from a task's checklist
+func (s *TaskService) DeleteChecklistItem(ctx context.Context, taskUID, itemUID uuid.UUID) error {
+	task, err := s.getTaskForChecklist(ctx, taskUID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.checklistRepo.Delete(ctx, task.ID, itemUID); err != nil {
+		if err.Error() == "checklist item not found" {
+			return utils.NewNotFoundError("Checklist item not found")
+		}
+		return utils.NewInternalError("Failed to delete checklist item")
+	}
+
+	return nil
+}
+
+func (s *TaskService) getTaskForChecklist(ctx context.Context, taskUID uuid.UUID) (*models.Task, error) {
+	task, err := s.taskRepo.GetByUID(ctx, taskUID)
+	if err != nil {
+		if err.Error() == "task not found" {
+			return nil, utils.NewNotFoundError("Task not found")
+		}
+		return nil, utils.NewInternalError("Failed to get task")
+	}
+
+	return task, nil
+}
+
+// newChecklistItemResponse maps a checklist item model to its API representation
+func newChecklistItemResponse(item *models.ChecklistItem) models.ChecklistItemResponse {
+	return models.ChecklistItemResponse{
+		ItemUID:     item.ItemUID,
+		Title:       item.Title,
+		IsCompleted: item.IsCompleted,
+		Position:    item.Position,
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+	}
+}
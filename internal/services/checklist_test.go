@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/internal/utils"
+)
+
+func checklistTitles(t *testing.T, items []models.ChecklistItemResponse) []string {
+	t.Helper()
+
+	titles := make([]string, len(items))
+	for i, item := range items {
+		if item.Position != i {
+			t.Fatalf("item %q has position %d, expected %d", item.Title, item.Position, i)
+		}
+		titles[i] = item.Title
+	}
+	return titles
+}
+
+func assertTitles(t *testing.T, got []string, want ...string) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestChecklistUnknownTask(t *testing.T) {
+	f := newTaskServiceFixture()
+	ctx := context.Background()
+
+	_, err := f.service.GetChecklist(ctx, uuid.New())
+	assertAppError(t, err, http.StatusNotFound)
+
+	_, err = f.service.AddChecklistItem(ctx, uuid.New(), &models.ChecklistItemRequest{Title: "Step"})
+	assertAppError(t, err, http.StatusNotFound)
+
+	err = f.service.DeleteChecklistItem(ctx, uuid.New(), uuid.New())
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestChecklistItemRequestValidation(t *testing.T) {
+	err := utils.ValidateStruct(&models.ChecklistItemRequest{Title: "Step", Position: intPtr(-1)})
+	if err == nil {
+		t.Fatal("expected a negative position to be rejected")
+	}
+
+	err = utils.ValidateStruct(&models.ChecklistItemRequest{})
+	if err == nil {
+		t.Fatal("expected a missing title to be rejected")
+	}
+}
+
+func TestAddChecklistItemAtPosition(t *testing.T) {
+	f := newTaskServiceFixture()
+	ctx := context.Background()
+	project := f.store.addProject("Roadmap")
+	task := f.store.addTask(f.store.addList(project, "Doing"), "Release")
+
+	for _, title := range []string{"Tag", "Publish"} {
+		if _, err := f.service.AddChecklistItem(ctx, task.TaskUID, &models.ChecklistItemRequest{Title: title}); err != nil {
+			t.Fatalf("AddChecklistItem(%q): %v", title, err)
+		}
+	}
+
+	item, err := f.service.AddChecklistItem(ctx, task.TaskUID, &models.ChecklistItemRequest{Title: "Test", Position: intPtr(0)})
+	if err != nil {
+		t.Fatalf("AddChecklistItem: %v", err)
+	}
+	if item.Position != 0 {
+		t.Errorf("expected the new item at position 0, got %d", item.Position)
+	}
+	if f.checklist.moves != 0 {
+		t.Errorf("expected the item to be positioned by Create alone, got %d MoveTo calls", f.checklist.moves)
+	}
+
+	items, err := f.service.GetChecklist(ctx, task.TaskUID)
+	if err != nil {
+		t.Fatalf("GetChecklist: %v", err)
+	}
+	assertTitles(t, checklistTitles(t, items), "Test", "Tag", "Publish")
+}
+
+func TestUpdateAndDeleteChecklistItem(t *testing.T) {
+	f := newTaskServiceFixture()
+	ctx := context.Background()
+	project := f.store.addProject("Roadmap")
+	task := f.store.addTask(f.store.addList(project, "Doing"), "Release")
+
+	var uids []uuid.UUID
+	for _, title := range []string{"Tag", "Test", "Publish"} {
+		item, err := f.service.AddChecklistItem(ctx, task.TaskUID, &models.ChecklistItemRequest{Title: title})
+		if err != nil {
+			t.Fatalf("AddChecklistItem(%q): %v", title, err)
+		}
+		uids = append(uids, item.ItemUID)
+	}
+
+	updated, err := f.service.UpdateChecklistItem(ctx, task.TaskUID, uids[0], &models.ChecklistItemUpdateRequest{
+		IsCompleted: boolPtr(true),
+		Position:    intPtr(2),
+	})
+	if err != nil {
+		t.Fatalf("UpdateChecklistItem: %v", err)
+	}
+	if !updated.IsCompleted || updated.Position != 2 {
+		t.Errorf("expected completed item at position 2, got %+v", updated)
+	}
+
+	if err := f.service.DeleteChecklistItem(ctx, task.TaskUID, uids[1]); err != nil {
+		t.Fatalf("DeleteChecklistItem: %v", err)
+	}
+
+	err = f.service.DeleteChecklistItem(ctx, task.TaskUID, uids[1])
+	assertAppError(t, err, http.StatusNotFound)
+
+	_, err = f.service.UpdateChecklistItem(ctx, task.TaskUID, uuid.New(), &models.ChecklistItemUpdateRequest{Title: strPtr("Gone")})
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestChecklistItemResponseFields(t *testing.T) {
+	f := newTaskServiceFixture()
+	task := f.store.addTask(f.store.addList(f.store.addProject("Roadmap"), "Doing"), "Release")
+
+	item, err := f.service.AddChecklistItem(context.Background(), task.TaskUID, &models.ChecklistItemRequest{Title: "Tag"})
+	if err != nil {
+		t.Fatalf("AddChecklistItem: %v", err)
+	}
+
+	body, err := json.Marshal(item)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	var keys []string
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if want := []string{"created_at", "is_completed", "item_uid", "position", "title", "updated_at"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("expected fields %v, got %v", want, keys)
+	}
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/internal/utils"
+)
+
+// AddDependency makes a task depend on another task in the same project
+func (s *TaskService) AddDependency(ctx context.Context, uid uuid.UUID, req *models.TaskDependencyRequest) (*models.TaskResponse, error) {
+	task, dependsOn, err := s.getDependencyPair(ctx, uid, req.DependsOnTaskUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.dependencyRepo.Add(ctx, task.ID, dependsOn.ID); err != nil {
+		switch err.Error() {
+		case "task cannot depend on itself":
+			return nil, utils.NewBadRequestError("A task cannot depend on itself")
+		case "tasks are in different projects":
+			return nil, utils.NewBadRequestError("Dependencies must be between tasks in the same project")
+		case "dependency already exists":
+			return nil, utils.NewConflictError("Dependency already exists")
+		case "dependency cycle":
+			return nil, utils.NewConflictError("Dependency would create a cycle")
+		}
+		return nil, utils.NewInternalError("Failed to add dependency")
+	}
+
+	return s.taskResponseWithDependencies(ctx, task)
+}
+
+// RemoveDependency removes a task's dependency on another task
+func (s *TaskService) RemoveDependency(ctx context.Context, uid, dependsOnUID uuid.UUID) (*models.TaskResponse, error) {
+	task, dependsOn, err := s.getDependencyPair(ctx, uid, dependsOnUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.dependencyRepo.Remove(ctx, task.ID, dependsOn.ID); err != nil {
+		if err.Error() == "dependency not found" {
+			return nil, utils.NewNotFoundError("Dependency not found")
+		}
+		return nil, utils.NewInternalError("Failed to remove dependency")
+	}
+
+	return s.taskResponseWithDependencies(ctx, task)
+}
+
+// ensureDependenciesComplete returns a conflict error if any task the given task depends on is still incomplete
+func (s *TaskService) ensureDependenciesComplete(ctx context.Context, task *models.Task) error {
+	count, err := s.dependencyRepo.CountIncomplete(ctx, task.ID)
+	if err != nil {
+		return utils.NewInternalError("Failed to check task dependencies")
+	}
+
+	if count > 0 {
+		return utils.NewConflictError(fmt.Sprintf("Task has %d incomplete dependencies", count))
+	}
+
+	return nil
+}
+
+// taskResponseWithDependencies maps a task to its API representation including the UIDs it depends on
+func (s *TaskService) taskResponseWithDependencies(ctx context.Context, task *models.Task) (*models.TaskResponse, error) {
+	response := newTaskResponse(task)
+
+	dependsOn, err := s.dependencyRepo.GetDependencyUIDs(ctx, task.ID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get task dependencies")
+	}
+	response.DependsOn = dependsOn
+
+	return &response, nil
+}
+
+func (s *TaskService) getDependencyPair(ctx context.Context, uid, dependsOnUID uuid.UUID) (*models.Task, *models.Task, error) {
+	task, err := s.taskRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "task not found" {
+			return nil, nil, utils.NewNotFoundError("Task not found")
+		}
+		return nil, nil, utils.NewInternalError("Failed to get task")
+	}
+
+	dependsOn, err := s.taskRepo.GetByUID(ctx, dependsOnUID)
+	if err != nil {
+		if err.Error() == "task not found" {
+			return nil, nil, utils.NewNotFoundError("Dependency task not found")
+		}
+		return nil, nil, utils.NewInternalError("Failed to get dependency task")
+	}
+
+	return task, dependsOn, nil
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"lucid-lists-backend/internal/models"
+)
+
+func TestCompletingTaskWithIncompleteDependencies(t *testing.T) {
+	f := newTaskServiceFixture()
+	project := f.store.addProject("Roadmap")
+	list := f.store.addList(project, "Doing")
+	task := f.store.addTask(list, "Release")
+	f.dependencies.incomplete = 2
+
+	tests := []struct {
+		name   string
+		update func() error
+	}{
+		{"partial is_completed", func() error {
+			_, err := f.service.PartialUpdateTask(context.Background(), task.TaskUID, &models.TaskUpdateRequest{IsCompleted: boolPtr(true)})
+			return err
+		}},
+		{"partial status", func() error {
+			_, err := f.service.PartialUpdateTask(context.Background(), task.TaskUID, &models.TaskUpdateRequest{Status: strPtr("completed")})
+			return err
+		}},
+		{"full update", func() error {
+			_, err := f.service.UpdateTask(context.Background(), task.TaskUID, &models.TaskRequest{
+				ListUID: list.ListUID,
+				Title:   task.Title,
+				Status:  "completed",
+			})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertAppError(t, tt.update(), http.StatusConflict)
+		})
+	}
+
+	if task.IsCompleted || task.Status != "todo" {
+		t.Errorf("expected the task to stay incomplete, got status %q completed %v", task.Status, task.IsCompleted)
+	}
+}
+
+func TestCompletingTaskWithCompleteDependencies(t *testing.T) {
+	f := newTaskServiceFixture()
+	project := f.store.addProject("Roadmap")
+	task := f.store.addTask(f.store.addList(project, "Doing"), "Release")
+
+	updated, err := f.service.PartialUpdateTask(context.Background(), task.TaskUID, &models.TaskUpdateRequest{IsCompleted: boolPtr(true)})
+	if err != nil {
+		t.Fatalf("PartialUpdateTask: %v", err)
+	}
+	if !updated.IsCompleted {
+		t.Errorf("expected the task to be completed, got %+v", updated)
+	}
+}
+
+func TestAddDependencyMapsRepositoryErrors(t *testing.T) {
+	tests := []struct {
+		err        error
+		statusCode int
+	}{
+		{fmt.Errorf("task cannot depend on itself"), http.StatusBadRequest},
+		{fmt.Errorf("tasks are in different projects"), http.StatusBadRequest},
+		{fmt.Errorf("dependency already exists"), http.StatusConflict},
+		{fmt.Errorf("dependency cycle"), http.StatusConflict},
+		{fmt.Errorf("failed to add dependency: connection reset"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		f := newTaskServiceFixture()
+		list := f.store.addList(f.store.addProject("Roadmap"), "Doing")
+		task := f.store.addTask(list, "Release")
+		dependsOn := f.store.addTask(list, "Review")
+		f.dependencies.addErr = tt.err
+
+		_, err := f.service.AddDependency(context.Background(), task.TaskUID, &models.TaskDependencyRequest{DependsOnTaskUID: dependsOn.TaskUID})
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			assertAppError(t, err, tt.statusCode)
+		})
+	}
+}
@@ -0,0 +1,1048 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/internal/repositories"
+	"lucid-lists-backend/internal/utils"
+)
+
+// The fakes below keep rows in memory and mirror the behaviour of the Postgres repositories,
+// including their "... not found" errors. Each embeds its interface so a test that reaches a
+// method the fake does not implement panics instead of silently passing.
+
+type fakeStore struct {
+	nextID   int
+	projects []*models.Project
+	lists    []*models.List
+	tasks    []*models.Task
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{}
+}
+
+func (s *fakeStore) id() int {
+	s.nextID++
+	return s.nextID
+}
+
+func (s *fakeStore) addProject(name string) *models.Project {
+	project := &models.Project{
+		ID:         s.id(),
+		ProjectUID: uuid.New(),
+		Name:       name,
+		Status:     "active",
+		Color:      "#3B82F6",
+		CreatedAt:  time.Now(),
+		IsActive:   true,
+	}
+	s.projects = append(s.projects, project)
+	return project
+}
+
+func (s *fakeStore) addList(project *models.Project, name string) *models.List {
+	list := &models.List{
+		ID:        s.id(),
+		ListUID:   uuid.New(),
+		ProjectID: project.ID,
+		Name:      name,
+		Color:     "#E2E8F0",
+		CreatedAt: time.Now(),
+		IsActive:  true,
+	}
+	s.lists = append(s.lists, list)
+	return list
+}
+
+func (s *fakeStore) addTask(list *models.List, title string) *models.Task {
+	position := len(s.tasksInList(list.ID)) + 1
+	task := &models.Task{
+		ID:        s.id(),
+		TaskUID:   uuid.New(),
+		ListID:    list.ID,
+		Title:     title,
+		Status:    "todo",
+		Color:     "#FFFFFF",
+		Position:  &position,
+		CreatedAt: time.Now(),
+		IsActive:  true,
+	}
+	s.tasks = append(s.tasks, task)
+	return task
+}
+
+func (s *fakeStore) tasksInList(listID int) []*models.Task {
+	var tasks []*models.Task
+	for _, task := range s.tasks {
+		if task.IsActive && task.ListID == listID {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks
+}
+
+func (s *fakeStore) listByID(id int) *models.List {
+	for _, list := range s.lists {
+		if list.ID == id {
+			return list
+		}
+	}
+	return nil
+}
+
+// projectLists returns the project's active lists in position order
+func (s *fakeStore) projectLists(projectID int) []*models.List {
+	var lists []*models.List
+	for _, list := range s.lists {
+		if list.IsActive && list.ProjectID == projectID {
+			lists = append(lists, list)
+		}
+	}
+	sort.SliceStable(lists, func(i, j int) bool { return lists[i].Position < lists[j].Position })
+	return lists
+}
+
+// projectTasks returns the active tasks in the project's active lists
+func (s *fakeStore) projectTasks(projectID int) []*models.Task {
+	var tasks []*models.Task
+	for _, task := range s.tasks {
+		list := s.listByID(task.ListID)
+		if task.IsActive && list != nil && list.IsActive && list.ProjectID == projectID {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks
+}
+
+// timestampParam mimics how pgx encodes a time.Time for a TIMESTAMP without time zone
+// parameter: the wall clock is kept and the zone is dropped.
+func timestampParam(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+type fakeProjectRepo struct {
+	repositories.ProjectRepository
+	store *fakeStore
+
+	// reorders records ReorderBoard calls, which fail with reorderErr; the reordering itself is covered by the repository tests
+	reorders   [][]models.BoardReorderList
+	reorderErr error
+
+	// board, when set, is what GetWithLists returns instead of the lists in the store
+	board *models.ProjectWithListsResponse
+
+	// restores records Restore calls, which fail with restoreErr; restoring is covered by the repository tests
+	restores   []uuid.UUID
+	restoreErr error
+
+	// positions records UpdatePositions calls, which fail with positionsErr; the update is covered by the repository tests
+	positions    []map[uuid.UUID]int
+	positionsErr error
+
+	// GetCounts returns counts and records each call in countCalls; the counting is covered by the repository tests
+	counts     map[int]models.ProjectCounts
+	countCalls int
+
+	includeArchived []bool
+}
+
+func (r *fakeProjectRepo) GetByUID(ctx context.Context, uid uuid.UUID) (*models.Project, error) {
+	for _, project := range r.store.projects {
+		if project.ProjectUID == uid && project.IsActive {
+			copied := *project
+			return &copied, nil
+		}
+	}
+	return nil, fmt.Errorf("project not found")
+}
+
+// GetAll records includeArchived and returns the active projects in the store; archive
+// filtering and ordering are covered by the repository tests
+func (r *fakeProjectRepo) GetAll(ctx context.Context, includeArchived bool) ([]models.Project, error) {
+	r.includeArchived = append(r.includeArchived, includeArchived)
+	var projects []models.Project
+	for _, project := range r.store.projects {
+		if project.IsActive {
+			projects = append(projects, *project)
+		}
+	}
+	return projects, nil
+}
+
+func (r *fakeProjectRepo) GetCounts(ctx context.Context) (map[int]models.ProjectCounts, error) {
+	r.countCalls++
+	return r.counts, nil
+}
+
+func (r *fakeProjectRepo) CountByStatus(ctx context.Context) (map[string]int, error) {
+	counts := map[string]int{}
+	for _, project := range r.store.projects {
+		if project.IsActive {
+			counts[project.Status]++
+		}
+	}
+	return counts, nil
+}
+
+func (r *fakeProjectRepo) CountByName(ctx context.Context, name string) (int, error) {
+	count := 0
+	for _, project := range r.store.projects {
+		if project.IsActive && strings.EqualFold(project.Name, name) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *fakeProjectRepo) ReorderBoard(ctx context.Context, projectID int, lists []models.BoardReorderList) error {
+	r.reorders = append(r.reorders, lists)
+	return r.reorderErr
+}
+
+func (r *fakeProjectRepo) UpdatePositions(ctx context.Context, positions map[uuid.UUID]int) error {
+	r.positions = append(r.positions, positions)
+	return r.positionsErr
+}
+
+func (r *fakeProjectRepo) Restore(ctx context.Context, uid uuid.UUID) error {
+	r.restores = append(r.restores, uid)
+	return r.restoreErr
+}
+
+func (r *fakeProjectRepo) GetWithLists(ctx context.Context, uid uuid.UUID, includeArchived bool) (*models.ProjectWithListsResponse, error) {
+	project, err := r.GetByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if r.board != nil {
+		return r.board, nil
+	}
+
+	lists := r.store.projectLists(project.ID)
+	response := &models.ProjectWithListsResponse{
+		ProjectResponse: newProjectResponse(project),
+		Lists:           []models.ListWithTasksResponse{},
+	}
+	for _, list := range lists {
+		listResponse := models.ListWithTasksResponse{
+			ListResponse: models.ListResponse{
+				ListUID:   list.ListUID,
+				Name:      list.Name,
+				Color:     list.Color,
+				Position:  list.Position,
+				CreatedAt: list.CreatedAt,
+			},
+			Tasks: []models.TaskResponse{},
+		}
+
+		tasks := r.store.tasksInList(list.ID)
+		sort.SliceStable(tasks, func(i, j int) bool { return positionOrLast(tasks[i].Position) < positionOrLast(tasks[j].Position) })
+		for _, task := range tasks {
+			if includeArchived || task.ArchivedAt == nil {
+				listResponse.Tasks = append(listResponse.Tasks, newTaskResponse(task))
+			}
+		}
+		response.Lists = append(response.Lists, listResponse)
+	}
+
+	return response, nil
+}
+
+func (r *fakeProjectRepo) GetMaxPositionByWorkspace(ctx context.Context, workspaceID int) (int, error) {
+	maxPosition := 0
+	for _, project := range r.store.projects {
+		if project.IsActive && project.Position != nil && *project.Position > maxPosition {
+			maxPosition = *project.Position
+		}
+	}
+	return maxPosition, nil
+}
+
+func (r *fakeProjectRepo) CreateWithContents(ctx context.Context, project *models.Project, lists []models.ListWithTasks) error {
+	project.ID = r.store.id()
+	project.CreatedAt = time.Now()
+	r.store.projects = append(r.store.projects, project)
+
+	for _, entry := range lists {
+		list := entry.List
+		list.ID = r.store.id()
+		list.ProjectID = project.ID
+		list.CreatedAt = time.Now()
+		r.store.lists = append(r.store.lists, &list)
+
+		for _, task := range entry.Tasks {
+			task.ID = r.store.id()
+			task.ListID = list.ID
+			task.CreatedAt = time.Now()
+			r.store.tasks = append(r.store.tasks, &task)
+		}
+	}
+	return nil
+}
+
+// positionOrLast sorts tasks without a position after the others, like COALESCE(position, 999999)
+func positionOrLast(position *int) int {
+	if position == nil {
+		return 999999
+	}
+	return *position
+}
+
+type fakeTemplateRepo struct {
+	repositories.TemplateRepository
+	templates []models.ProjectTemplate
+}
+
+func (r *fakeTemplateRepo) GetAll(ctx context.Context) ([]models.ProjectTemplate, error) {
+	return r.templates, nil
+}
+
+func (r *fakeTemplateRepo) GetByUID(ctx context.Context, uid uuid.UUID) (*models.ProjectTemplate, error) {
+	for i := range r.templates {
+		if r.templates[i].TemplateUID == uid {
+			return &r.templates[i], nil
+		}
+	}
+	return nil, fmt.Errorf("template not found")
+}
+
+type fakeListRepo struct {
+	repositories.ListRepository
+	store *fakeStore
+}
+
+func (r *fakeListRepo) GetByUID(ctx context.Context, uid uuid.UUID) (*models.List, error) {
+	for _, list := range r.store.lists {
+		if list.ListUID == uid && list.IsActive {
+			copied := *list
+			return &copied, nil
+		}
+	}
+	return nil, fmt.Errorf("list not found")
+}
+
+func (r *fakeListRepo) GetByProjectID(ctx context.Context, projectID int) ([]models.List, error) {
+	var lists []models.List
+	for _, list := range r.store.projectLists(projectID) {
+		lists = append(lists, *list)
+	}
+	return lists, nil
+}
+
+func (r *fakeListRepo) GetMaxPositionByProject(ctx context.Context, projectID int) (int, error) {
+	maxPosition := 0
+	for _, list := range r.store.projectLists(projectID) {
+		if list.Position > maxPosition {
+			maxPosition = list.Position
+		}
+	}
+	return maxPosition, nil
+}
+
+func (r *fakeListRepo) CreateBatch(ctx context.Context, lists []models.List) error {
+	for i := range lists {
+		lists[i].ID = r.store.id()
+		lists[i].CreatedAt = time.Now()
+		stored := lists[i]
+		r.store.lists = append(r.store.lists, &stored)
+	}
+	return nil
+}
+
+type fakeTaskRepo struct {
+	repositories.TaskRepository
+	store *fakeStore
+	moves []fakeMove
+
+	// overdueNow and overdueLimit record the GetOverdueGroupedByProject arguments; it returns overdueGroups
+	overdueNow    []time.Time
+	overdueLimit  int
+	overdueGroups []models.ProjectOverdueResponse
+
+	// overdueBefore records the GetOverdueByProject cutoffs; it returns overdueTasks
+	overdueBefore []time.Time
+	overdueTasks  []models.Task
+
+	// dailySince records the GetDailyCountsByList cutoffs; it returns dailyCounts
+	dailySince  []time.Time
+	dailyCounts []models.DailyTaskCounts
+
+	// bulkChanges and bulkErr are what UpdateFieldBulk returns; the update itself is covered by the repository tests
+	bulkUpdates []fakeBulkUpdate
+	bulkChanges []models.TaskFieldChange
+	bulkErr     error
+
+	// queries records the filters passed to Query, which returns queryTasks; the SQL is covered by the repository tests
+	queries    []models.TaskFilter
+	queryTasks []models.Task
+}
+
+// fakeBulkUpdate records an UpdateFieldBulk call
+type fakeBulkUpdate struct {
+	uids  []uuid.UUID
+	field string
+	value string
+}
+
+// fakeMove records a MoveToList call; renumbering the lists is covered by the repository tests
+type fakeMove struct {
+	uid      uuid.UUID
+	listID   int
+	position *int
+}
+
+func (r *fakeTaskRepo) GetByUID(ctx context.Context, uid uuid.UUID) (*models.Task, error) {
+	for _, task := range r.store.tasks {
+		if task.TaskUID == uid && task.IsActive {
+			copied := *task
+			return &copied, nil
+		}
+	}
+	return nil, fmt.Errorf("task not found")
+}
+
+func (r *fakeTaskRepo) GetByProjectID(ctx context.Context, projectID int) ([]models.Task, error) {
+	var tasks []models.Task
+	for _, list := range r.store.projectLists(projectID) {
+		listTasks := r.store.tasksInList(list.ID)
+		sort.SliceStable(listTasks, func(i, j int) bool {
+			return positionOrLast(listTasks[i].Position) < positionOrLast(listTasks[j].Position)
+		})
+		for _, task := range listTasks {
+			tasks = append(tasks, *task)
+		}
+	}
+	return tasks, nil
+}
+
+func (r *fakeTaskRepo) GetByListID(ctx context.Context, listID int) ([]models.Task, error) {
+	var tasks []models.Task
+	for _, task := range r.store.tasksInList(listID) {
+		tasks = append(tasks, *task)
+	}
+	return tasks, nil
+}
+
+func (r *fakeTaskRepo) GetMaxPositionByList(ctx context.Context, listID int) (int, error) {
+	maxPosition := 0
+	for _, task := range r.store.tasksInList(listID) {
+		if task.Position != nil && *task.Position > maxPosition {
+			maxPosition = *task.Position
+		}
+	}
+	return maxPosition, nil
+}
+
+func (r *fakeTaskRepo) Create(ctx context.Context, task *models.Task) error {
+	task.ID = r.store.id()
+	task.CreatedAt = time.Now()
+	stored := *task
+	r.store.tasks = append(r.store.tasks, &stored)
+	return nil
+}
+
+func (r *fakeTaskRepo) CreateBatch(ctx context.Context, tasks []models.Task) error {
+	for i := range tasks {
+		if err := r.Create(ctx, &tasks[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *fakeTaskRepo) find(uid uuid.UUID) *models.Task {
+	for _, task := range r.store.tasks {
+		if task.TaskUID == uid && task.IsActive {
+			return task
+		}
+	}
+	return nil
+}
+
+func (r *fakeTaskRepo) Update(ctx context.Context, uid uuid.UUID, task *models.Task) error {
+	stored := r.find(uid)
+	if stored == nil {
+		return fmt.Errorf("task not found")
+	}
+
+	now := time.Now()
+	completedAt := task.CompletedAt
+	if task.IsCompleted && completedAt == nil {
+		completedAt = &now
+	} else if !task.IsCompleted {
+		completedAt = nil
+	}
+
+	stored.Title = task.Title
+	stored.Description = task.Description
+	stored.Priority = task.Priority
+	stored.Status = task.Status
+	stored.Color = task.Color
+	stored.Position = task.Position
+	stored.IsCompleted = task.IsCompleted
+	stored.DueDate = task.DueDate
+	stored.CompletedAt = completedAt
+	stored.Recurrence = task.Recurrence
+	stored.UpdatedAt = &now
+	return nil
+}
+
+func (r *fakeTaskRepo) PartialUpdate(ctx context.Context, uid uuid.UUID, updates models.TaskUpdateRequest) error {
+	stored := r.find(uid)
+	if stored == nil {
+		return fmt.Errorf("task not found")
+	}
+
+	now := time.Now()
+	if updates.Title != nil {
+		stored.Title = *updates.Title
+	}
+	if updates.Description != nil {
+		stored.Description = updates.Description
+	}
+	if updates.Priority != nil {
+		stored.Priority = updates.Priority
+	}
+	if updates.Status != nil {
+		stored.Status = *updates.Status
+		if *updates.Status == "completed" {
+			stored.CompletedAt = &now
+		} else {
+			stored.CompletedAt = nil
+		}
+	}
+	if updates.Color != nil {
+		stored.Color = *updates.Color
+	}
+	if updates.Position != nil {
+		stored.Position = updates.Position
+	}
+	if updates.DueDate != nil {
+		stored.DueDate = updates.DueDate
+	}
+	if updates.Recurrence != nil {
+		stored.Recurrence = updates.Recurrence
+	}
+	if updates.IsCompleted != nil {
+		stored.IsCompleted = *updates.IsCompleted
+		if *updates.IsCompleted {
+			stored.CompletedAt = &now
+		} else {
+			stored.CompletedAt = nil
+		}
+	}
+	stored.UpdatedAt = &now
+	return nil
+}
+
+func (r *fakeTaskRepo) UpdateFieldBulk(ctx context.Context, uids []uuid.UUID, field string, value string) ([]models.TaskFieldChange, error) {
+	r.bulkUpdates = append(r.bulkUpdates, fakeBulkUpdate{uids: uids, field: field, value: value})
+	return r.bulkChanges, r.bulkErr
+}
+
+func (r *fakeTaskRepo) ArchiveCompletedByProject(ctx context.Context, projectID int) (int, error) {
+	now := time.Now()
+	archived := 0
+	for _, task := range r.store.projectTasks(projectID) {
+		if task.IsCompleted && task.ArchivedAt == nil {
+			task.ArchivedAt = &now
+			archived++
+		}
+	}
+	return archived, nil
+}
+
+// Query supports the filters but only the default board order sort
+func (r *fakeTaskRepo) Query(ctx context.Context, projectID int, filter models.TaskFilter) ([]models.Task, error) {
+	r.queries = append(r.queries, filter)
+	return r.queryTasks, nil
+}
+
+func (r *fakeTaskRepo) GetDailyCountsByList(ctx context.Context, listID int, since time.Time) ([]models.DailyTaskCounts, error) {
+	r.dailySince = append(r.dailySince, since)
+	return r.dailyCounts, nil
+}
+
+func (r *fakeTaskRepo) GetByProjectDueBetween(ctx context.Context, projectID int, start, end time.Time) ([]models.Task, error) {
+	start, end = timestampParam(start), timestampParam(end)
+
+	var tasks []models.Task
+	for _, task := range r.store.projectTasks(projectID) {
+		if task.ArchivedAt == nil && task.DueDate != nil && !task.DueDate.Before(start) && task.DueDate.Before(end) {
+			tasks = append(tasks, *task)
+		}
+	}
+	sort.SliceStable(tasks, func(i, j int) bool { return tasks[i].DueDate.Before(*tasks[j].DueDate) })
+	return tasks, nil
+}
+
+func (r *fakeTaskRepo) GetDueDatesByProject(ctx context.Context, projectID int, start, end time.Time) ([]time.Time, error) {
+	tasks, err := r.GetByProjectDueBetween(ctx, projectID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var dueDates []time.Time
+	for _, task := range tasks {
+		dueDates = append(dueDates, *task.DueDate)
+	}
+	return dueDates, nil
+}
+
+func (r *fakeTaskRepo) GetOverdueByProject(ctx context.Context, projectID int, before time.Time) ([]models.Task, error) {
+	r.overdueBefore = append(r.overdueBefore, before)
+	return r.overdueTasks, nil
+}
+
+func (r *fakeTaskRepo) GetOverdueGroupedByProject(ctx context.Context, now time.Time, tasksPerProject int) ([]models.ProjectOverdueResponse, error) {
+	r.overdueNow = append(r.overdueNow, now)
+	r.overdueLimit = tasksPerProject
+	return r.overdueGroups, nil
+}
+
+func (r *fakeTaskRepo) MoveToList(ctx context.Context, uid uuid.UUID, newListID int, position *int) error {
+	moved := r.find(uid)
+	if moved == nil {
+		return fmt.Errorf("task not found")
+	}
+	r.moves = append(r.moves, fakeMove{uid: uid, listID: newListID, position: position})
+	moved.ListID = newListID
+	moved.Position = position
+	return nil
+}
+
+type fakeTaskHistoryRepo struct {
+	repositories.TaskHistoryRepository
+	store   *fakeStore
+	entries map[int][]models.TaskHistory
+
+	// recentLimit records the GetRecentByProject limit; it returns recent
+	recentLimit int
+	recent      []models.TaskActivity
+}
+
+func newFakeTaskHistoryRepo(store *fakeStore) *fakeTaskHistoryRepo {
+	return &fakeTaskHistoryRepo{store: store, entries: map[int][]models.TaskHistory{}}
+}
+
+func (r *fakeTaskHistoryRepo) Record(ctx context.Context, taskID int, entries []models.TaskHistory, keep int) error {
+	for _, entry := range entries {
+		entry.TaskID = taskID
+		entry.ChangedAt = time.Now()
+		r.entries[taskID] = append([]models.TaskHistory{entry}, r.entries[taskID]...)
+	}
+	if len(r.entries[taskID]) > keep {
+		r.entries[taskID] = r.entries[taskID][:keep]
+	}
+	return nil
+}
+
+func (r *fakeTaskHistoryRepo) GetByTaskID(ctx context.Context, taskID int) ([]models.TaskHistory, error) {
+	return r.entries[taskID], nil
+}
+
+func (r *fakeTaskHistoryRepo) GetRecentByProject(ctx context.Context, projectID int, limit int) ([]models.TaskActivity, error) {
+	r.recentLimit = limit
+	return r.recent, nil
+}
+
+type fakeProjectStateRepo struct {
+	repositories.ProjectStateRepository
+	store     *fakeStore
+	snapshots []models.ProjectStateSnapshot
+}
+
+func (r *fakeProjectStateRepo) Create(ctx context.Context, snapshot *models.ProjectStateSnapshot, keep int) error {
+	snapshot.ID = r.store.id()
+	snapshot.CreatedAt = time.Now()
+	r.snapshots = append([]models.ProjectStateSnapshot{*snapshot}, r.snapshots...)
+
+	kept := 0
+	snapshots := r.snapshots[:0]
+	for _, stored := range r.snapshots {
+		if stored.ProjectID == snapshot.ProjectID {
+			kept++
+			if kept > keep {
+				continue
+			}
+		}
+		snapshots = append(snapshots, stored)
+	}
+	r.snapshots = snapshots
+	return nil
+}
+
+func (r *fakeProjectStateRepo) GetByProjectID(ctx context.Context, projectID int) ([]models.ProjectStateSnapshot, error) {
+	var snapshots []models.ProjectStateSnapshot
+	for _, snapshot := range r.snapshots {
+		if snapshot.ProjectID == projectID {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	return snapshots, nil
+}
+
+func (r *fakeProjectStateRepo) GetByUID(ctx context.Context, projectID int, uid uuid.UUID) (*models.ProjectStateSnapshot, error) {
+	for _, snapshot := range r.snapshots {
+		if snapshot.ProjectID == projectID && snapshot.SnapshotUID == uid {
+			return &snapshot, nil
+		}
+	}
+	return nil, fmt.Errorf("snapshot not found")
+}
+
+// Restore reactivates or recreates every list and task in the state by uid and deactivates the rest
+func (r *fakeProjectStateRepo) Restore(ctx context.Context, projectID int, state *models.ProjectState) error {
+	keepLists := map[*models.List]bool{}
+	keepTasks := map[*models.Task]bool{}
+
+	for _, stateList := range state.Lists {
+		var list *models.List
+		for _, candidate := range r.store.lists {
+			if candidate.ListUID == stateList.ListUID && candidate.ProjectID == projectID {
+				list = candidate
+			}
+		}
+		if list == nil {
+			list = &models.List{ID: r.store.id(), ListUID: stateList.ListUID, ProjectID: projectID, CreatedAt: time.Now()}
+			r.store.lists = append(r.store.lists, list)
+		}
+		list.Name, list.Color, list.Position, list.IsActive = stateList.Name, stateList.Color, stateList.Position, true
+		keepLists[list] = true
+
+		for _, stateTask := range stateList.Tasks {
+			var task *models.Task
+			for _, candidate := range r.store.tasks {
+				if list := r.store.listByID(candidate.ListID); candidate.TaskUID == stateTask.TaskUID && list.ProjectID == projectID {
+					task = candidate
+				}
+			}
+			if task == nil {
+				task = &models.Task{ID: r.store.id(), TaskUID: stateTask.TaskUID, CreatedAt: time.Now()}
+				r.store.tasks = append(r.store.tasks, task)
+			}
+			task.ListID = list.ID
+			task.Title = stateTask.Title
+			task.Description = stateTask.Description
+			task.Priority = stateTask.Priority
+			task.Status = stateTask.Status
+			task.Color = stateTask.Color
+			task.Position = stateTask.Position
+			task.IsCompleted = stateTask.IsCompleted
+			task.DueDate = stateTask.DueDate
+			task.CompletedAt = stateTask.CompletedAt
+			task.ArchivedAt = stateTask.ArchivedAt
+			task.Recurrence = stateTask.Recurrence
+			task.IsActive = true
+			keepTasks[task] = true
+		}
+	}
+
+	for _, task := range r.store.projectTasks(projectID) {
+		if !keepTasks[task] {
+			task.IsActive = false
+		}
+	}
+	for _, list := range r.store.projectLists(projectID) {
+		if !keepLists[list] {
+			list.IsActive = false
+		}
+	}
+	return nil
+}
+
+type fakeTaskDefaultsRepo struct {
+	repositories.TaskDefaultsRepository
+	defaults map[int]models.ProjectTaskDefaults
+}
+
+func newFakeTaskDefaultsRepo() *fakeTaskDefaultsRepo {
+	return &fakeTaskDefaultsRepo{defaults: map[int]models.ProjectTaskDefaults{}}
+}
+
+func (r *fakeTaskDefaultsRepo) GetByProjectID(ctx context.Context, projectID int) (*models.ProjectTaskDefaults, error) {
+	defaults, ok := r.defaults[projectID]
+	if !ok {
+		return nil, fmt.Errorf("task defaults not found")
+	}
+	return &defaults, nil
+}
+
+func (r *fakeTaskDefaultsRepo) Upsert(ctx context.Context, defaults *models.ProjectTaskDefaults) error {
+	now := time.Now()
+	defaults.UpdatedAt = &now
+	r.defaults[defaults.ProjectID] = *defaults
+	return nil
+}
+
+type fakeDependencyRepo struct {
+	repositories.DependencyRepository
+
+	// Add fails with addErr and CountIncomplete returns incomplete; the checks behind
+	// both are covered by the repository tests
+	addErr     error
+	incomplete int
+}
+
+func (r *fakeDependencyRepo) Add(ctx context.Context, taskID, dependsOnID int) error {
+	return r.addErr
+}
+
+func (r *fakeDependencyRepo) GetDependencyUIDs(ctx context.Context, taskID int) ([]uuid.UUID, error) {
+	return []uuid.UUID{}, nil
+}
+
+func (r *fakeDependencyRepo) CountIncomplete(ctx context.Context, taskID int) (int, error) {
+	return r.incomplete, nil
+}
+
+type fakeChecklistRepo struct {
+	repositories.ChecklistRepository
+	items  []*models.ChecklistItem
+	nextID int
+	moves  int
+}
+
+// ordered returns the task's active items in position order
+func (r *fakeChecklistRepo) ordered(taskID int, excludeID int) []*models.ChecklistItem {
+	var items []*models.ChecklistItem
+	for _, item := range r.items {
+		if item.TaskID == taskID && item.IsActive && item.ID != excludeID {
+			items = append(items, item)
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Position < items[j].Position })
+	return items
+}
+
+func (r *fakeChecklistRepo) renumber(items []*models.ChecklistItem) {
+	for i, item := range items {
+		item.Position = i
+	}
+}
+
+func (r *fakeChecklistRepo) GetByTaskID(ctx context.Context, taskID int) ([]models.ChecklistItem, error) {
+	var items []models.ChecklistItem
+	for _, item := range r.ordered(taskID, 0) {
+		items = append(items, *item)
+	}
+	return items, nil
+}
+
+func (r *fakeChecklistRepo) GetByUID(ctx context.Context, taskID int, uid uuid.UUID) (*models.ChecklistItem, error) {
+	for _, item := range r.ordered(taskID, 0) {
+		if item.ItemUID == uid {
+			copied := *item
+			return &copied, nil
+		}
+	}
+	return nil, fmt.Errorf("checklist item not found")
+}
+
+func (r *fakeChecklistRepo) Create(ctx context.Context, item *models.ChecklistItem, position *int) error {
+	items := r.ordered(item.TaskID, 0)
+	insertAt := len(items)
+	if position != nil && *position < insertAt {
+		insertAt = *position
+	}
+
+	r.nextID++
+	stored := *item
+	stored.ID = r.nextID
+	stored.CreatedAt = time.Now()
+	stored.IsActive = true
+	r.items = append(r.items, &stored)
+
+	ordered := append(append(append([]*models.ChecklistItem{}, items[:insertAt]...), &stored), items[insertAt:]...)
+	r.renumber(ordered)
+
+	*item = stored
+	return nil
+}
+
+func (r *fakeChecklistRepo) Update(ctx context.Context, item *models.ChecklistItem) error {
+	for _, stored := range r.items {
+		if stored.ID == item.ID && stored.IsActive {
+			stored.Title = item.Title
+			stored.IsCompleted = item.IsCompleted
+			return nil
+		}
+	}
+	return fmt.Errorf("checklist item not found")
+}
+
+func (r *fakeChecklistRepo) MoveTo(ctx context.Context, taskID int, itemID int, position int) error {
+	r.moves++
+	var moved *models.ChecklistItem
+	for _, item := range r.items {
+		if item.ID == itemID {
+			moved = item
+		}
+	}
+
+	items := r.ordered(taskID, itemID)
+	if position > len(items) {
+		position = len(items)
+	}
+
+	ordered := append(append(append([]*models.ChecklistItem{}, items[:position]...), moved), items[position:]...)
+	r.renumber(ordered)
+	return nil
+}
+
+func (r *fakeChecklistRepo) Delete(ctx context.Context, taskID int, uid uuid.UUID) error {
+	for _, item := range r.ordered(taskID, 0) {
+		if item.ItemUID == uid {
+			item.IsActive = false
+			return nil
+		}
+	}
+	return fmt.Errorf("checklist item not found")
+}
+
+type fakeLinkRepo struct {
+	repositories.LinkRepository
+	links  []*models.TaskLink
+	nextID int
+}
+
+func (r *fakeLinkRepo) GetByTaskID(ctx context.Context, taskID int) ([]models.TaskLink, error) {
+	var links []models.TaskLink
+	for _, link := range r.links {
+		if link.TaskID == taskID && link.IsActive {
+			links = append(links, *link)
+		}
+	}
+	return links, nil
+}
+
+func (r *fakeLinkRepo) Create(ctx context.Context, link *models.TaskLink) error {
+	r.nextID++
+	link.ID = r.nextID
+	link.CreatedAt = time.Now()
+	link.IsActive = true
+	stored := *link
+	r.links = append(r.links, &stored)
+	return nil
+}
+
+func (r *fakeLinkRepo) Delete(ctx context.Context, taskID int, uid uuid.UUID) error {
+	for _, link := range r.links {
+		if link.TaskID == taskID && link.LinkUID == uid && link.IsActive {
+			link.IsActive = false
+			return nil
+		}
+	}
+	return fmt.Errorf("task link not found")
+}
+
+// taskServiceFixture wires a TaskService to fakes sharing one store
+type taskServiceFixture struct {
+	store        *fakeStore
+	tasks        *fakeTaskRepo
+	history      *fakeTaskHistoryRepo
+	checklist    *fakeChecklistRepo
+	defaults     *fakeTaskDefaultsRepo
+	dependencies *fakeDependencyRepo
+	service      *TaskService
+}
+
+func newTaskServiceFixture() *taskServiceFixture {
+	store := newFakeStore()
+	history := newFakeTaskHistoryRepo(store)
+	checklist := &fakeChecklistRepo{}
+	links := &fakeLinkRepo{}
+	defaults := newFakeTaskDefaultsRepo()
+	dependencies := &fakeDependencyRepo{}
+	tasks := &fakeTaskRepo{store: store}
+	service := NewTaskService(
+		tasks,
+		&fakeListRepo{store: store},
+		history,
+		checklist,
+		defaults,
+		dependencies,
+		links,
+	)
+	return &taskServiceFixture{store: store, tasks: tasks, history: history, checklist: checklist, defaults: defaults, dependencies: dependencies, service: service}
+}
+
+// listServiceFixture wires a ListService to fakes sharing one store
+type listServiceFixture struct {
+	store   *fakeStore
+	tasks   *fakeTaskRepo
+	service *ListService
+}
+
+func newListServiceFixture() *listServiceFixture {
+	store := newFakeStore()
+	tasks := &fakeTaskRepo{store: store}
+	service := NewListService(&fakeListRepo{store: store}, tasks, &fakeProjectRepo{store: store})
+	return &listServiceFixture{store: store, tasks: tasks, service: service}
+}
+
+// projectServiceFixture wires a ProjectService to fakes sharing one store
+type projectServiceFixture struct {
+	store     *fakeStore
+	projects  *fakeProjectRepo
+	tasks     *fakeTaskRepo
+	templates *fakeTemplateRepo
+	states    *fakeProjectStateRepo
+	history   *fakeTaskHistoryRepo
+	service   *ProjectService
+}
+
+func newProjectServiceFixture() *projectServiceFixture {
+	store := newFakeStore()
+	projects := &fakeProjectRepo{store: store}
+	tasks := &fakeTaskRepo{store: store}
+	templates := &fakeTemplateRepo{}
+	states := &fakeProjectStateRepo{store: store}
+	history := newFakeTaskHistoryRepo(store)
+	service := NewProjectService(
+		projects,
+		&fakeListRepo{store: store},
+		tasks,
+		nil,
+		templates,
+		nil,
+		states,
+		history,
+	)
+	return &projectServiceFixture{store: store, projects: projects, tasks: tasks, templates: templates, states: states, history: history, service: service}
+}
+
+// assertAppError fails the test unless err is an *utils.AppError with the given status code
+func assertAppError(t *testing.T, err error, statusCode int) {
+	t.Helper()
+
+	var appErr *utils.AppError
+	if !errors.As(err, &appErr) {
+		t.Fatalf("expected *utils.AppError with status %d, got %v", statusCode, err)
+	}
+	if appErr.StatusCode != statusCode {
+		t.Fatalf("expected status %d, got %d (%s)", statusCode, appErr.StatusCode, appErr.Message)
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func intPtr(i int) *int {
+	return &i
+}
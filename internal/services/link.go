@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/internal/utils"
+)
+
+// GetLinks returns a task's links, oldest first
+func (s *TaskService) GetLinks(ctx context.Context, taskUID uuid.UUID) ([]models.TaskLinkResponse, error) {
+	task, err := s.taskRepo.GetByUID(ctx, taskUID)
+	if err != nil {
+		if err.Error() == "task not found" {
+			return nil, utils.NewNotFoundError("Task not found")
+		}
+		return nil, utils.NewInternalError("Failed to get task")
+	}
+
+	links, err := s.linkRepo.GetByTaskID(ctx, task.ID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get task links")
+	}
+
+	response := []models.TaskLinkResponse{}
+	for i := range links {
+		response = append(response, newTaskLinkResponse(&links[i]))
+	}
+
+	return response, nil
+}
+
+// AddLink attaches an external URL to a task
+func (s *TaskService) AddLink(ctx context.Context, taskUID uuid.UUID, req *models.TaskLinkRequest) (*models.TaskLinkResponse, error) {
+	task, err := s.taskRepo.GetByUID(ctx, taskUID)
+	if err != nil {
+		if err.Error() == "task not found" {
+			return nil, utils.NewNotFoundError("Task not found")
+		}
+		return nil, utils.NewInternalError("Failed to get task")
+	}
+
+	link := &models.TaskLink{
+		LinkUID: uuid.New(),
+		TaskID:  task.ID,
+		URL:     req.URL,
+		Title:   req.Title,
+	}
+
+	if err := s.linkRepo.Create(ctx, link); err != nil {
+		return nil, utils.NewInternalError("Failed to create task link")
+	}
+
+	response := newTaskLinkResponse(link)
+	return &response, nil
+}
+
+// RemoveLink detaches a link from a task
+func (s *TaskService) RemoveLink(ctx context.Context, taskUID, linkUID uuid.UUID) error {
+	task, err := s.taskRepo.GetByUID(ctx, taskUID)
+	if err != nil {
+		if err.Error() == "task not found" {
+			return utils.NewNotFoundError("Task not found")
+		}
+		return utils.NewInternalError("Failed to get task")
+	}
+
+	if err := s.linkRepo.Delete(ctx, task.ID, linkUID); err != nil {
+		if err.Error() == "task link not found" {
+			return utils.NewNotFoundError("Task link not found")
+		}
+		return utils.NewInternalError("Failed to delete task link")
+	}
+
+	return nil
+}
+
+func newTaskLinkResponse(link *models.TaskLink) models.TaskLinkResponse {
+	return models.TaskLinkResponse{
+		LinkUID:   link.LinkUID,
+		URL:       link.URL,
+		Title:     link.Title,
+		CreatedAt: link.CreatedAt,
+	}
+}
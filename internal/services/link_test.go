@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/internal/utils"
+)
+
+func TestLinksUnknownTask(t *testing.T) {
+	f := newTaskServiceFixture()
+	ctx := context.Background()
+
+	_, err := f.service.GetLinks(ctx, uuid.New())
+	assertAppError(t, err, http.StatusNotFound)
+
+	_, err = f.service.AddLink(ctx, uuid.New(), &models.TaskLinkRequest{URL: "https://example.com"})
+	assertAppError(t, err, http.StatusNotFound)
+
+	err = f.service.RemoveLink(ctx, uuid.New(), uuid.New())
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestTaskLinkRequestValidation(t *testing.T) {
+	for _, url := range []string{"", "not a url", "ftp://example.com/file"} {
+		if err := utils.ValidateStruct(&models.TaskLinkRequest{URL: url}); err == nil {
+			t.Errorf("expected %q to be rejected", url)
+		}
+	}
+}
+
+func TestAddAndRemoveLink(t *testing.T) {
+	f := newTaskServiceFixture()
+	ctx := context.Background()
+	project := f.store.addProject("Roadmap")
+	task := f.store.addTask(f.store.addList(project, "Doing"), "Release")
+
+	link, err := f.service.AddLink(ctx, task.TaskUID, &models.TaskLinkRequest{
+		URL:   "https://example.com/spec",
+		Title: strPtr("Spec"),
+	})
+	if err != nil {
+		t.Fatalf("AddLink: %v", err)
+	}
+	if link.LinkUID == uuid.Nil || link.URL != "https://example.com/spec" || *link.Title != "Spec" {
+		t.Errorf("unexpected link: %+v", link)
+	}
+
+	links, err := f.service.GetLinks(ctx, task.TaskUID)
+	if err != nil {
+		t.Fatalf("GetLinks: %v", err)
+	}
+	if len(links) != 1 || links[0].LinkUID != link.LinkUID {
+		t.Fatalf("expected the new link, got %+v", links)
+	}
+
+	if err := f.service.RemoveLink(ctx, task.TaskUID, link.LinkUID); err != nil {
+		t.Fatalf("RemoveLink: %v", err)
+	}
+
+	links, err = f.service.GetLinks(ctx, task.TaskUID)
+	if err != nil {
+		t.Fatalf("GetLinks: %v", err)
+	}
+	if len(links) != 0 {
+		t.Errorf("expected no links after removal, got %+v", links)
+	}
+
+	err = f.service.RemoveLink(ctx, task.TaskUID, link.LinkUID)
+	assertAppError(t, err, http.StatusNotFound)
+}
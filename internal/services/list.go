@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -10,6 +12,9 @@ import (
 	"lucid-lists-backend/internal/utils"
 )
 
+// maxBulkLists caps how many lists a single bulk create may insert
+const maxBulkLists = 50
+
 type ListService struct {
 	listRepo    repositories.ListRepository
 	taskRepo    repositories.TaskRepository
@@ -43,18 +48,12 @@ func (s *ListService) CreateList(ctx context.Context, req *models.ListRequest) (
 		req.Position = maxPosition + 1
 	}
 
-	// Set default color if not provided
-	color := req.Color
-	if color == "" {
-		color = "#FFFFFF"
-	}
-
 	// Create list model
 	list := &models.List{
 		ListUID:   uuid.New(),
 		ProjectID: project.ID,
 		Name:      req.Name,
-		Color:     color,
+		Color:     defaultColor(req.Color),
 		Position:  req.Position,
 		IsActive:  true,
 		CreatedBy: nil, // No user authentication yet
@@ -74,6 +73,106 @@ func (s *ListService) CreateList(ctx context.Context, req *models.ListRequest) (
 	}, nil
 }
 
+// CreateListsBulk creates lists at the end of a project's board in request order, all or nothing
+func (s *ListService) CreateListsBulk(ctx context.Context, projectUID uuid.UUID, req *models.BulkCreateListsRequest) ([]models.ListResponse, error) {
+	if len(req.Lists) > maxBulkLists {
+		return nil, utils.NewBadRequestError(fmt.Sprintf("At most %d lists can be created at once", maxBulkLists))
+	}
+
+	project, err := s.projectRepo.GetByUID(ctx, projectUID)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	maxPosition, err := s.listRepo.GetMaxPositionByProject(ctx, project.ID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get max position")
+	}
+
+	lists := make([]models.List, 0, len(req.Lists))
+	for i, item := range req.Lists {
+		lists = append(lists, models.List{
+			ListUID:   uuid.New(),
+			ProjectID: project.ID,
+			Name:      item.Name,
+			Color:     defaultColor(item.Color),
+			Position:  maxPosition + i + 1,
+			IsActive:  true,
+		})
+	}
+
+	if err := s.listRepo.CreateBatch(ctx, lists); err != nil {
+		return nil, utils.NewInternalError("Failed to create lists")
+	}
+
+	response := make([]models.ListResponse, 0, len(lists))
+	for _, list := range lists {
+		response = append(response, models.ListResponse{
+			ListUID:   list.ListUID,
+			Name:      list.Name,
+			Color:     list.Color,
+			Position:  list.Position,
+			CreatedAt: list.CreatedAt,
+			UpdatedAt: list.UpdatedAt,
+		})
+	}
+
+	return response, nil
+}
+
+// GetListTrend returns daily created and completed task counts for a list over the last n days,
+// oldest first, with a zero entry for days without activity
+func (s *ListService) GetListTrend(ctx context.Context, projectUID, listUID uuid.UUID, days int) ([]models.ListTrendDayResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, projectUID)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	list, err := s.listRepo.GetByUID(ctx, listUID)
+	if err != nil {
+		if err.Error() == "list not found" {
+			return nil, utils.NewNotFoundError("List not found")
+		}
+		return nil, utils.NewInternalError("Failed to get list")
+	}
+	if list.ProjectID != project.ID {
+		return nil, utils.NewNotFoundError("List not found")
+	}
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	since := today.AddDate(0, 0, -(days - 1))
+
+	counts, err := s.taskRepo.GetDailyCountsByList(ctx, list.ID, since)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get list trend")
+	}
+
+	byDate := make(map[string]models.DailyTaskCounts, len(counts))
+	for _, c := range counts {
+		byDate[c.Day.Format(utils.DateLayout)] = c
+	}
+
+	trend := make([]models.ListTrendDayResponse, 0, days)
+	for day := since; !day.After(today); day = day.AddDate(0, 0, 1) {
+		date := day.Format(utils.DateLayout)
+		c := byDate[date]
+		trend = append(trend, models.ListTrendDayResponse{
+			Date:      date,
+			Created:   c.Created,
+			Completed: c.Completed,
+		})
+	}
+
+	return trend, nil
+}
+
 func (s *ListService) UpdateList(ctx context.Context, uid uuid.UUID, req *models.ListRequest) (*models.ListResponse, error) {
 	// Check if list exists
 	_, err := s.listRepo.GetByUID(ctx, uid)
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/internal/utils"
+)
+
+func TestBulkCreateListsRequestValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		req  models.BulkCreateListsRequest
+	}{
+		{"missing lists", models.BulkCreateListsRequest{}},
+		{"empty lists", models.BulkCreateListsRequest{Lists: []models.BulkListItem{}}},
+		{"missing name", models.BulkCreateListsRequest{Lists: []models.BulkListItem{{Color: "#FF0000"}}}},
+		{"bad color", models.BulkCreateListsRequest{Lists: []models.BulkListItem{{Name: "To do", Color: "red"}}}},
+	}
+
+	for _, tt := range tests {
+		if err := utils.ValidateStruct(&tt.req); err == nil {
+			t.Errorf("%s: expected a validation error", tt.name)
+		}
+	}
+}
+
+func TestCreateListsBulkAppendsInRequestOrder(t *testing.T) {
+	f := newListServiceFixture()
+	project := f.store.addProject("Launch")
+	f.store.addList(project, "Backlog").Position = 1
+	f.store.addList(project, "Doing").Position = 2
+
+	created, err := f.service.CreateListsBulk(context.Background(), project.ProjectUID, &models.BulkCreateListsRequest{
+		Lists: []models.BulkListItem{
+			{Name: "Review", Color: "#FF0000"},
+			{Name: "Done"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateListsBulk: %v", err)
+	}
+
+	if len(created) != 2 {
+		t.Fatalf("expected 2 lists, got %+v", created)
+	}
+	if l := created[0]; l.Name != "Review" || l.Position != 3 || l.Color != "#FF0000" {
+		t.Errorf("unexpected first list: %+v", l)
+	}
+	if l := created[1]; l.Name != "Done" || l.Position != 4 || l.Color != "#FFFFFF" {
+		t.Errorf("expected the second list at position 4 with the default color, got %+v", l)
+	}
+	if lists := f.store.projectLists(project.ID); len(lists) != 4 {
+		t.Errorf("expected 4 lists on the board, got %d", len(lists))
+	}
+}
+
+func TestCreateListsBulkTooManyLists(t *testing.T) {
+	f := newListServiceFixture()
+	project := f.store.addProject("Launch")
+
+	req := &models.BulkCreateListsRequest{}
+	for i := 0; i <= maxBulkLists; i++ {
+		req.Lists = append(req.Lists, models.BulkListItem{Name: fmt.Sprintf("List %d", i)})
+	}
+
+	_, err := f.service.CreateListsBulk(context.Background(), project.ProjectUID, req)
+	assertAppError(t, err, http.StatusBadRequest)
+
+	if lists := f.store.projectLists(project.ID); len(lists) != 0 {
+		t.Errorf("expected nothing to be created, got %d lists", len(lists))
+	}
+}
+
+func TestCreateListsBulkUnknownProject(t *testing.T) {
+	f := newListServiceFixture()
+
+	_, err := f.service.CreateListsBulk(context.Background(), uuid.New(), &models.BulkCreateListsRequest{
+		Lists: []models.BulkListItem{{Name: "To do"}},
+	})
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestGetListTrendFillsEmptyDays(t *testing.T) {
+	f := newListServiceFixture()
+	project := f.store.addProject("Launch")
+	list := f.store.addList(project, "Doing")
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	f.tasks.dailyCounts = []models.DailyTaskCounts{
+		{Day: today.AddDate(0, 0, -2), Created: 2},
+		{Day: today, Completed: 1},
+	}
+
+	trend, err := f.service.GetListTrend(context.Background(), project.ProjectUID, list.ListUID, 3)
+	if err != nil {
+		t.Fatalf("GetListTrend: %v", err)
+	}
+
+	if len(f.tasks.dailySince) != 1 || !f.tasks.dailySince[0].Equal(today.AddDate(0, 0, -2)) {
+		t.Errorf("expected counts since the start of the first day, got %v", f.tasks.dailySince)
+	}
+
+	want := []models.ListTrendDayResponse{
+		{Date: today.AddDate(0, 0, -2).Format(utils.DateLayout), Created: 2},
+		{Date: today.AddDate(0, 0, -1).Format(utils.DateLayout)},
+		{Date: today.Format(utils.DateLayout), Completed: 1},
+	}
+	if len(trend) != len(want) {
+		t.Fatalf("expected %d days, got %+v", len(want), trend)
+	}
+	for i := range want {
+		if trend[i] != want[i] {
+			t.Errorf("day %d: expected %+v, got %+v", i, want[i], trend[i])
+		}
+	}
+}
+
+func TestGetListTrendNotFound(t *testing.T) {
+	f := newListServiceFixture()
+	project := f.store.addProject("Launch")
+	other := f.store.addList(f.store.addProject("Other"), "Doing")
+
+	tests := []struct {
+		name       string
+		projectUID uuid.UUID
+		listUID    uuid.UUID
+	}{
+		{"unknown project", uuid.New(), other.ListUID},
+		{"unknown list", project.ProjectUID, uuid.New()},
+		{"list in another project", project.ProjectUID, other.ListUID},
+	}
+
+	for _, tt := range tests {
+		_, err := f.service.GetListTrend(context.Background(), tt.projectUID, tt.listUID, 7)
+		assertAppError(t, err, http.StatusNotFound)
+	}
+}
@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -11,46 +13,156 @@ import (
 )
 
 type ProjectService struct {
-	projectRepo repositories.ProjectRepository
-	listRepo    repositories.ListRepository
-	taskRepo    repositories.TaskRepository
+	projectRepo  repositories.ProjectRepository
+	listRepo     repositories.ListRepository
+	taskRepo     repositories.TaskRepository
+	snapshotRepo repositories.ProgressSnapshotRepository
+	templateRepo repositories.TemplateRepository
+	defaultsRepo repositories.TaskDefaultsRepository
+	stateRepo    repositories.ProjectStateRepository
+	historyRepo  repositories.TaskHistoryRepository
 }
 
-func NewProjectService(projectRepo repositories.ProjectRepository, listRepo repositories.ListRepository, taskRepo repositories.TaskRepository) *ProjectService {
+func NewProjectService(projectRepo repositories.ProjectRepository, listRepo repositories.ListRepository, taskRepo repositories.TaskRepository, snapshotRepo repositories.ProgressSnapshotRepository, templateRepo repositories.TemplateRepository, defaultsRepo repositories.TaskDefaultsRepository, stateRepo repositories.ProjectStateRepository, historyRepo repositories.TaskHistoryRepository) *ProjectService {
 	return &ProjectService{
-		projectRepo: projectRepo,
-		listRepo:    listRepo,
-		taskRepo:    taskRepo,
+		projectRepo:  projectRepo,
+		listRepo:     listRepo,
+		taskRepo:     taskRepo,
+		snapshotRepo: snapshotRepo,
+		templateRepo: templateRepo,
+		defaultsRepo: defaultsRepo,
+		stateRepo:    stateRepo,
+		historyRepo:  historyRepo,
 	}
 }
 
-func (s *ProjectService) GetAllProjects(ctx context.Context) ([]models.ProjectResponse, error) {
-	projects, err := s.projectRepo.GetAll(ctx)
+// ProjectListOptions controls what GetAllProjects returns
+type ProjectListOptions struct {
+	IncludeArchived bool
+	IncludeCounts   bool
+}
+
+func (s *ProjectService) GetAllProjects(ctx context.Context, opts ProjectListOptions) ([]models.ProjectResponse, error) {
+	projects, err := s.projectRepo.GetAll(ctx, opts.IncludeArchived)
 	if err != nil {
 		return nil, utils.NewInternalError("Failed to retrieve projects")
 	}
 
+	var counts map[int]models.ProjectCounts
+	if opts.IncludeCounts {
+		counts, err = s.projectRepo.GetCounts(ctx)
+		if err != nil {
+			return nil, utils.NewInternalError("Failed to count project contents")
+		}
+	}
+
 	var response []models.ProjectResponse
-	for _, project := range projects {
-		response = append(response, models.ProjectResponse{
-			ProjectUID:  project.ProjectUID,
-			Name:        project.Name,
-			Description: project.Description,
-			Status:      project.Status,
-			Color:       project.Color,
-			Position:    project.Position,
-			StartDate:   project.StartDate,
-			EndDate:     project.EndDate,
-			CreatedAt:   project.CreatedAt,
-			UpdatedAt:   project.UpdatedAt,
-		})
+	for i := range projects {
+		project := newProjectResponse(&projects[i])
+		if opts.IncludeCounts {
+			projectCounts := counts[projects[i].ID]
+			project.ListCount = &projectCounts.ListCount
+			project.TaskCount = &projectCounts.TaskCount
+		}
+		response = append(response, project)
 	}
 
 	return response, nil
 }
 
-func (s *ProjectService) GetProjectWithLists(ctx context.Context, uid uuid.UUID) (*models.ProjectWithListsResponse, error) {
-	projectWithLists, err := s.projectRepo.GetWithLists(ctx, uid)
+// GetStatusCounts returns how many active projects are in each status
+func (s *ProjectService) GetStatusCounts(ctx context.Context) (*models.ProjectStatusCountsResponse, error) {
+	counts, err := s.projectRepo.CountByStatus(ctx)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to count projects")
+	}
+
+	return &models.ProjectStatusCountsResponse{
+		Active:    counts["active"],
+		Inactive:  counts["inactive"],
+		Completed: counts["completed"],
+	}, nil
+}
+
+// CheckNameAvailable reports whether no active project already uses the name
+func (s *ProjectService) CheckNameAvailable(ctx context.Context, name string) (*models.ProjectNameAvailabilityResponse, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, utils.NewBadRequestError("name is required")
+	}
+
+	count, err := s.projectRepo.CountByName(ctx, name)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to check project name")
+	}
+
+	return &models.ProjectNameAvailabilityResponse{
+		Name:      name,
+		Available: count == 0,
+	}, nil
+}
+
+// ReorderProjects applies a batch of project positions atomically and returns the reordered project list
+func (s *ProjectService) ReorderProjects(ctx context.Context, req *models.ProjectReorderRequest) ([]models.ProjectResponse, error) {
+	positions := make(map[uuid.UUID]int, len(req.Items))
+	for _, item := range req.Items {
+		if _, exists := positions[item.ProjectUID]; exists {
+			return nil, utils.NewBadRequestError("Duplicate project_uid in reorder request: " + item.ProjectUID.String())
+		}
+		positions[item.ProjectUID] = item.Position
+	}
+
+	if err := s.projectRepo.UpdatePositions(ctx, positions); err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("One or more projects not found")
+		}
+		return nil, utils.NewInternalError("Failed to reorder projects")
+	}
+
+	return s.GetAllProjects(ctx, ProjectListOptions{})
+}
+
+// ReorderBoard applies a board-wide task order, possibly moving tasks between lists, in one transaction
+func (s *ProjectService) ReorderBoard(ctx context.Context, uid uuid.UUID, req *models.BoardReorderRequest) (*models.ProjectWithListsResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	seenLists := make(map[uuid.UUID]bool, len(req.Lists))
+	seenTasks := make(map[uuid.UUID]bool)
+	for _, list := range req.Lists {
+		if seenLists[list.ListUID] {
+			return nil, utils.NewBadRequestError("Duplicate list_uid in reorder request: " + list.ListUID.String())
+		}
+		seenLists[list.ListUID] = true
+
+		for _, taskUID := range list.TaskUIDs {
+			if seenTasks[taskUID] {
+				return nil, utils.NewBadRequestError("Duplicate task_uid in reorder request: " + taskUID.String())
+			}
+			seenTasks[taskUID] = true
+		}
+	}
+
+	if err := s.projectRepo.ReorderBoard(ctx, project.ID, req.Lists); err != nil {
+		switch err.Error() {
+		case "list not found":
+			return nil, utils.NewBadRequestError("One or more lists do not belong to this project")
+		case "task not found":
+			return nil, utils.NewBadRequestError("One or more tasks do not belong to this project")
+		}
+		return nil, utils.NewInternalError("Failed to reorder board")
+	}
+
+	return s.GetProjectWithLists(ctx, uid, false)
+}
+
+func (s *ProjectService) GetProjectWithLists(ctx context.Context, uid uuid.UUID, includeArchived bool) (*models.ProjectWithListsResponse, error) {
+	projectWithLists, err := s.projectRepo.GetWithLists(ctx, uid, includeArchived)
 	if err != nil {
 		if err.Error() == "project not found" {
 			return nil, utils.NewNotFoundError("Project not found")
@@ -112,6 +224,7 @@ func (s *ProjectService) CreateProject(ctx context.Context, req *models.ProjectR
 		Position:    project.Position,
 		StartDate:   project.StartDate,
 		EndDate:     project.EndDate,
+		ArchivedAt:  project.ArchivedAt,
 		CreatedAt:   project.CreatedAt,
 		UpdatedAt:   project.UpdatedAt,
 	}, nil
@@ -168,6 +281,7 @@ func (s *ProjectService) UpdateProject(ctx context.Context, uid uuid.UUID, req *
 		Position:    updatedProject.Position,
 		StartDate:   updatedProject.StartDate,
 		EndDate:     updatedProject.EndDate,
+		ArchivedAt:  updatedProject.ArchivedAt,
 		CreatedAt:   updatedProject.CreatedAt,
 		UpdatedAt:   updatedProject.UpdatedAt,
 	}, nil
@@ -203,11 +317,41 @@ func (s *ProjectService) PartialUpdateProject(ctx context.Context, uid uuid.UUID
 		Position:    updatedProject.Position,
 		StartDate:   updatedProject.StartDate,
 		EndDate:     updatedProject.EndDate,
+		ArchivedAt:  updatedProject.ArchivedAt,
 		CreatedAt:   updatedProject.CreatedAt,
 		UpdatedAt:   updatedProject.UpdatedAt,
 	}, nil
 }
 
+// setArchived archives or unarchives a project. Archived projects stay readable
+// by UID but are hidden from the default project list. Deletion remains separate.
+func (s *ProjectService) setArchived(ctx context.Context, uid uuid.UUID, archived bool) (*models.ProjectResponse, error) {
+	if err := s.projectRepo.SetArchived(ctx, uid, archived); err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to update project archive state")
+	}
+
+	updatedProject, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get updated project")
+	}
+
+	response := newProjectResponse(updatedProject)
+	return &response, nil
+}
+
+// ArchiveProject hides a project from the default project list
+func (s *ProjectService) ArchiveProject(ctx context.Context, uid uuid.UUID) (*models.ProjectResponse, error) {
+	return s.setArchived(ctx, uid, true)
+}
+
+// UnarchiveProject returns an archived project to the default project list
+func (s *ProjectService) UnarchiveProject(ctx context.Context, uid uuid.UUID) (*models.ProjectResponse, error) {
+	return s.setArchived(ctx, uid, false)
+}
+
 func (s *ProjectService) DeleteProject(ctx context.Context, uid uuid.UUID) error {
 	// Check if project exists
 	_, err := s.projectRepo.GetByUID(ctx, uid)
@@ -224,3 +368,534 @@ func (s *ProjectService) DeleteProject(ctx context.Context, uid uuid.UUID) error
 
 	return nil
 }
+
+// GetProgressHistory returns the recorded daily progress snapshots for the last n days
+func (s *ProjectService) GetProgressHistory(ctx context.Context, uid uuid.UUID, days int) ([]models.ProgressSnapshotResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	since := time.Now().UTC().AddDate(0, 0, -days)
+	snapshots, err := s.snapshotRepo.GetByProjectID(ctx, project.ID, since)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get progress history")
+	}
+
+	response := []models.ProgressSnapshotResponse{}
+	for _, snapshot := range snapshots {
+		percentage := 0.0
+		if snapshot.TotalTasks > 0 {
+			percentage = float64(snapshot.CompletedTasks) / float64(snapshot.TotalTasks) * 100
+		}
+		response = append(response, models.ProgressSnapshotResponse{
+			Date:               snapshot.SnapshotDate.Format("2006-01-02"),
+			TotalTasks:         snapshot.TotalTasks,
+			CompletedTasks:     snapshot.CompletedTasks,
+			ProgressPercentage: percentage,
+		})
+	}
+
+	return response, nil
+}
+
+// ArchiveCompletedTasks archives every completed task in the project so it drops off the board
+func (s *ProjectService) ArchiveCompletedTasks(ctx context.Context, uid uuid.UUID) (*models.ArchiveCompletedTasksResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	archived, err := s.taskRepo.ArchiveCompletedByProject(ctx, project.ID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to archive completed tasks")
+	}
+
+	return &models.ArchiveCompletedTasksResponse{Archived: archived}, nil
+}
+
+// GetTaskCalendar returns the project's tasks due in [start, end), grouped by day in loc
+func (s *ProjectService) GetTaskCalendar(ctx context.Context, uid uuid.UUID, start, end time.Time, loc *time.Location) ([]models.CalendarDayResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	// due_date is a TIMESTAMP without time zone holding UTC, so the bounds must be UTC too
+	tasks, err := s.taskRepo.GetByProjectDueBetween(ctx, project.ID, start.UTC(), end.UTC())
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get tasks")
+	}
+
+	// Tasks arrive ordered by due date, so days are appended in order
+	days := []models.CalendarDayResponse{}
+	for i := range tasks {
+		date := tasks[i].DueDate.In(loc).Format(utils.DateLayout)
+		if len(days) == 0 || days[len(days)-1].Date != date {
+			days = append(days, models.CalendarDayResponse{Date: date, Tasks: []models.TaskResponse{}})
+		}
+		days[len(days)-1].Tasks = append(days[len(days)-1].Tasks, newTaskResponse(&tasks[i]))
+	}
+
+	return days, nil
+}
+
+// GetColorUsage returns the distinct colors used across the project's lists and tasks with usage counts
+func (s *ProjectService) GetColorUsage(ctx context.Context, uid uuid.UUID) ([]models.ColorUsageResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	usage, err := s.projectRepo.GetColorUsage(ctx, project.ID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get color usage")
+	}
+
+	return usage, nil
+}
+
+// GetFlatTasks returns all of the project's tasks as flat rows ordered by list position, then task position
+func (s *ProjectService) GetFlatTasks(ctx context.Context, uid uuid.UUID) ([]models.FlatTaskResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	tasks, err := s.taskRepo.GetFlatByProject(ctx, project.ID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get tasks")
+	}
+
+	return tasks, nil
+}
+
+// GetTasksWithoutDueDate returns the project's incomplete tasks that have no due date, in board order
+func (s *ProjectService) GetTasksWithoutDueDate(ctx context.Context, uid uuid.UUID) ([]models.TaskResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	isCompleted, hasDueDate := false, false
+	tasks, err := s.taskRepo.Query(ctx, project.ID, models.TaskFilter{
+		IsCompleted: &isCompleted,
+		HasDueDate:  &hasDueDate,
+	})
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get tasks")
+	}
+
+	response := []models.TaskResponse{}
+	for i := range tasks {
+		response = append(response, newTaskResponse(&tasks[i]))
+	}
+
+	return response, nil
+}
+
+// GetOverdueTasks returns the project's incomplete tasks that were due before today in loc.
+// A task due at any time today is not yet overdue. Tasks without a due date never are.
+func (s *ProjectService) GetOverdueTasks(ctx context.Context, uid uuid.UUID, loc *time.Location) (*models.OverdueTasksResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	now := time.Now().In(loc)
+	startOfToday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	tasks, err := s.taskRepo.GetOverdueByProject(ctx, project.ID, startOfToday.UTC())
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get overdue tasks")
+	}
+
+	response := &models.OverdueTasksResponse{
+		Count: len(tasks),
+		Tasks: []models.TaskResponse{},
+	}
+	for i := range tasks {
+		response.Tasks = append(response.Tasks, newTaskResponse(&tasks[i]))
+	}
+
+	return response, nil
+}
+
+// GetDueDays returns the days within [start, end) that have unarchived tasks due, with a count per day.
+// Days are calendar dates in loc.
+func (s *ProjectService) GetDueDays(ctx context.Context, uid uuid.UUID, start, end time.Time, loc *time.Location) ([]models.DueDayResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	// due_date is a TIMESTAMP without time zone holding UTC, so the bounds must be UTC too
+	dueDates, err := s.taskRepo.GetDueDatesByProject(ctx, project.ID, start.UTC(), end.UTC())
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get task due dates")
+	}
+
+	// Due dates arrive in order, so days are appended in order
+	days := []models.DueDayResponse{}
+	for _, dueDate := range dueDates {
+		date := dueDate.In(loc).Format(utils.DateLayout)
+		if len(days) == 0 || days[len(days)-1].Date != date {
+			days = append(days, models.DueDayResponse{Date: date})
+		}
+		days[len(days)-1].Count++
+	}
+
+	return days, nil
+}
+
+// GetTaskDefaults returns the defaults applied to new tasks in the project. Unset fields are null.
+func (s *ProjectService) GetTaskDefaults(ctx context.Context, uid uuid.UUID) (*models.TaskDefaultsResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	defaults, err := s.defaultsRepo.GetByProjectID(ctx, project.ID)
+	if err != nil {
+		if err.Error() == "task defaults not found" {
+			return &models.TaskDefaultsResponse{}, nil
+		}
+		return nil, utils.NewInternalError("Failed to get task defaults")
+	}
+
+	return &models.TaskDefaultsResponse{
+		Priority:  defaults.Priority,
+		Status:    defaults.Status,
+		Color:     defaults.Color,
+		UpdatedAt: defaults.UpdatedAt,
+	}, nil
+}
+
+// UpdateTaskDefaults replaces the project's task defaults. Omitted fields are cleared.
+func (s *ProjectService) UpdateTaskDefaults(ctx context.Context, uid uuid.UUID, req *models.TaskDefaultsRequest) (*models.TaskDefaultsResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	defaults := &models.ProjectTaskDefaults{
+		ProjectID: project.ID,
+		Priority:  req.Priority,
+		Status:    req.Status,
+		Color:     req.Color,
+	}
+
+	if err := s.defaultsRepo.Upsert(ctx, defaults); err != nil {
+		return nil, utils.NewInternalError("Failed to update task defaults")
+	}
+
+	return &models.TaskDefaultsResponse{
+		Priority:  defaults.Priority,
+		Status:    defaults.Status,
+		Color:     defaults.Color,
+		UpdatedAt: defaults.UpdatedAt,
+	}, nil
+}
+
+// newProjectResponse maps a project model to its API representation
+func newProjectResponse(project *models.Project) models.ProjectResponse {
+	return models.ProjectResponse{
+		ProjectUID:  project.ProjectUID,
+		Name:        project.Name,
+		Description: project.Description,
+		Status:      project.Status,
+		Color:       project.Color,
+		Position:    project.Position,
+		StartDate:   project.StartDate,
+		EndDate:     project.EndDate,
+		ArchivedAt:  project.ArchivedAt,
+		CreatedAt:   project.CreatedAt,
+		UpdatedAt:   project.UpdatedAt,
+	}
+}
+
+// RestoreProject brings back a soft-deleted project
+func (s *ProjectService) RestoreProject(ctx context.Context, uid uuid.UUID) (*models.ProjectResponse, error) {
+	if err := s.projectRepo.Restore(ctx, uid); err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Deleted project not found")
+		}
+		return nil, utils.NewInternalError("Failed to restore project")
+	}
+
+	restoredProject, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get restored project")
+	}
+
+	response := newProjectResponse(restoredProject)
+	return &response, nil
+}
+
+// CloneProject creates a new project with copies of the source project's active lists and
+// unarchived tasks. Positions, colors, priorities, and descriptions are kept; completion is reset.
+func (s *ProjectService) CloneProject(ctx context.Context, srcUID uuid.UUID, newName *string) (*models.ProjectWithListsResponse, error) {
+	source, err := s.projectRepo.GetByUID(ctx, srcUID)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	name := source.Name + " (copy)"
+	if newName != nil {
+		name = *newName
+	}
+
+	var position *int
+	maxPos, err := s.projectRepo.GetMaxPositionByWorkspace(ctx, 1)
+	if err == nil {
+		newPos := maxPos + 1
+		position = &newPos
+	}
+
+	project := &models.Project{
+		ProjectUID:  uuid.New(),
+		WorkspaceID: 1, // Default workspace
+		Name:        name,
+		Description: source.Description,
+		Status:      "active",
+		Color:       source.Color,
+		Position:    position,
+		StartDate:   source.StartDate,
+		EndDate:     source.EndDate,
+		IsActive:    true,
+	}
+
+	sourceLists, err := s.listRepo.GetByProjectID(ctx, source.ID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get lists")
+	}
+
+	lists := make([]models.ListWithTasks, 0, len(sourceLists))
+	for _, sourceList := range sourceLists {
+		sourceTasks, err := s.taskRepo.GetByListID(ctx, sourceList.ID)
+		if err != nil {
+			return nil, utils.NewInternalError("Failed to get tasks")
+		}
+
+		tasks := make([]models.Task, 0, len(sourceTasks))
+		for _, sourceTask := range sourceTasks {
+			if sourceTask.ArchivedAt != nil {
+				continue
+			}
+
+			status := sourceTask.Status
+			if status == "completed" {
+				status = "todo"
+			}
+
+			tasks = append(tasks, models.Task{
+				TaskUID:     uuid.New(),
+				Title:       sourceTask.Title,
+				Description: sourceTask.Description,
+				Priority:    sourceTask.Priority,
+				Status:      status,
+				Color:       sourceTask.Color,
+				Position:    sourceTask.Position,
+				IsCompleted: false,
+				DueDate:     sourceTask.DueDate,
+				Recurrence:  sourceTask.Recurrence,
+				IsActive:    true,
+			})
+		}
+
+		lists = append(lists, models.ListWithTasks{
+			List: models.List{
+				ListUID:  uuid.New(),
+				Name:     sourceList.Name,
+				Color:    sourceList.Color,
+				Position: sourceList.Position,
+				IsActive: true,
+			},
+			Tasks: tasks,
+		})
+	}
+
+	if err := s.projectRepo.CreateWithContents(ctx, project, lists); err != nil {
+		return nil, utils.NewInternalError("Failed to clone project")
+	}
+
+	return s.GetProjectWithLists(ctx, project.ProjectUID, false)
+}
+
+// CreateFromTemplate creates a project populated with the lists and seed tasks of a template.
+// Everything is written in a single transaction through CreateWithContents rather than through
+// CreateList and CreateTask, but lists and tasks are defaulted by the same helpers those use.
+// The project is new and has no task defaults of its own, so tasks get the global defaults.
+func (s *ProjectService) CreateFromTemplate(ctx context.Context, req *models.ProjectFromTemplateRequest) (*models.ProjectWithListsResponse, error) {
+	template, err := s.templateRepo.GetByUID(ctx, req.TemplateUID)
+	if err != nil {
+		if err.Error() == "template not found" {
+			return nil, utils.NewNotFoundError("Template not found")
+		}
+		return nil, utils.NewInternalError("Failed to get template")
+	}
+
+	body, err := parseTemplateBody(template.Body)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to read template")
+	}
+
+	var position *int
+	maxPos, err := s.projectRepo.GetMaxPositionByWorkspace(ctx, 1)
+	if err == nil {
+		newPos := maxPos + 1
+		position = &newPos
+	}
+
+	color := req.Color
+	if color == "" {
+		color = "#FFFFFF"
+	}
+
+	description := req.Description
+	if description == nil {
+		description = template.Description
+	}
+
+	project := &models.Project{
+		ProjectUID:  uuid.New(),
+		WorkspaceID: 1, // Default workspace
+		Name:        req.Name,
+		Description: description,
+		Status:      "active",
+		Color:       color,
+		Position:    position,
+		IsActive:    true,
+	}
+
+	lists := make([]models.ListWithTasks, 0, len(body.Lists))
+	for i, templateList := range body.Lists {
+		tasks := make([]models.Task, 0, len(templateList.Tasks))
+		for j, templateTask := range templateList.Tasks {
+			taskPosition := j + 1
+			priority, status, color := taskDefaults{}.apply(templateTask.Priority, "", templateTask.Color)
+			tasks = append(tasks, models.Task{
+				TaskUID:     uuid.New(),
+				Title:       templateTask.Title,
+				Description: templateTask.Description,
+				Priority:    priority,
+				Status:      status,
+				Color:       color,
+				Position:    &taskPosition,
+				IsActive:    true,
+			})
+		}
+
+		lists = append(lists, models.ListWithTasks{
+			List: models.List{
+				ListUID:  uuid.New(),
+				Name:     templateList.Name,
+				Color:    defaultColor(templateList.Color),
+				Position: i + 1,
+				IsActive: true,
+			},
+			Tasks: tasks,
+		})
+	}
+
+	if err := s.projectRepo.CreateWithContents(ctx, project, lists); err != nil {
+		return nil, utils.NewInternalError("Failed to create project from template")
+	}
+
+	return s.GetProjectWithLists(ctx, project.ProjectUID, false)
+}
+
+// defaultColor returns the color, or white when none is set
+func defaultColor(color string) string {
+	if color == "" {
+		return "#FFFFFF"
+	}
+	return color
+}
+
+// GetBoardSummary returns the project's lists with task counts instead of full task bodies
+func (s *ProjectService) GetBoardSummary(ctx context.Context, uid uuid.UUID) ([]models.ListSummaryResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	summaries, err := s.listRepo.GetSummariesByProjectID(ctx, project.ID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get board summary")
+	}
+
+	return summaries, nil
+}
+
+// GetIntegrityReport lists data left behind by deleted lists and tasks in the project
+func (s *ProjectService) GetIntegrityReport(ctx context.Context, uid uuid.UUID) (*models.IntegrityReportResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	report, err := s.projectRepo.GetIntegrityReport(ctx, project.ID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to check project integrity")
+	}
+
+	return report, nil
+}
+
+// RepairIntegrity removes the orphaned data reported by GetIntegrityReport
+func (s *ProjectService) RepairIntegrity(ctx context.Context, uid uuid.UUID) (*models.IntegrityRepairResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	result, err := s.projectRepo.RepairOrphans(ctx, project.ID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to repair project integrity")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,193 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/internal/utils"
+)
+
+// reportActivityLimit is how many recent task changes the project report lists
+const reportActivityLimit = 20
+
+type projectReportData struct {
+	Project     models.ProjectResponse
+	Lists       []projectReportList
+	Total       int
+	Completed   int
+	Percentage  int
+	Activity    []models.TaskActivity
+	GeneratedAt time.Time
+	Location    *time.Location
+}
+
+type projectReportList struct {
+	Name      string
+	Color     string
+	Completed int
+	Tasks     []models.TaskResponse
+}
+
+// GetProjectReport renders a self-contained, printable HTML summary of the project.
+// Dates are shown in loc.
+func (s *ProjectService) GetProjectReport(ctx context.Context, uid uuid.UUID, loc *time.Location) ([]byte, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	board, err := s.projectRepo.GetWithLists(ctx, uid, false)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get project lists")
+	}
+
+	activity, err := s.historyRepo.GetRecentByProject(ctx, project.ID, reportActivityLimit)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get project activity")
+	}
+
+	data := projectReportData{
+		Project:     board.ProjectResponse,
+		Activity:    activity,
+		GeneratedAt: time.Now(),
+		Location:    loc,
+	}
+
+	for _, list := range board.Lists {
+		reportList := projectReportList{Name: list.Name, Color: list.Color, Tasks: list.Tasks}
+		for _, task := range list.Tasks {
+			if task.IsCompleted || task.Status == "completed" {
+				reportList.Completed++
+			}
+		}
+		data.Total += len(list.Tasks)
+		data.Completed += reportList.Completed
+		data.Lists = append(data.Lists, reportList)
+	}
+
+	if data.Total > 0 {
+		data.Percentage = data.Completed * 100 / data.Total
+	}
+
+	var buf bytes.Buffer
+	if err := projectReportTemplate.Execute(&buf, data); err != nil {
+		return nil, utils.NewInternalError("Failed to render project report")
+	}
+
+	return buf.Bytes(), nil
+}
+
+var projectReportTemplate = template.Must(template.New("project-report").Funcs(template.FuncMap{
+	"date": func(t *time.Time, loc *time.Location) string {
+		if t == nil {
+			return ""
+		}
+		return t.In(loc).Format(utils.DateLayout)
+	},
+	"datetime": func(t time.Time, loc *time.Location) string {
+		return t.In(loc).Format("2006-01-02 15:04 MST")
+	},
+	"text": func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Project.Name}} – Project report</title>
+<style>
+  body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; color: #222; margin: 2rem; }
+  h1 { margin-bottom: 0.25rem; }
+  h2 { border-bottom: 1px solid #ccc; padding-bottom: 0.25rem; margin-top: 2rem; }
+  h3 { margin-bottom: 0.5rem; }
+  .meta { color: #666; font-size: 0.9rem; }
+  .bar { background: #eee; height: 0.75rem; width: 100%; max-width: 30rem; border-radius: 0.375rem; overflow: hidden; }
+  .bar span { display: block; height: 100%; background: #4caf50; }
+  table { border-collapse: collapse; width: 100%; font-size: 0.9rem; margin-bottom: 1rem; }
+  th, td { border: 1px solid #ddd; padding: 0.375rem 0.5rem; text-align: left; vertical-align: top; }
+  th { background: #f5f5f5; }
+  .swatch { display: inline-block; width: 0.75rem; height: 0.75rem; border-radius: 0.125rem; margin-right: 0.375rem; border: 1px solid #999; }
+  .done { color: #888; text-decoration: line-through; }
+  @media print { body { margin: 0; } h2 { page-break-after: avoid; } table { page-break-inside: auto; } tr { page-break-inside: avoid; } }
+</style>
+</head>
+<body>
+<header>
+  <h1>{{.Project.Name}}</h1>
+  <p class="meta">
+    Status: {{.Project.Status}}
+    {{- with .Project.StartDate}} · Start: {{date . $.Location}}{{end}}
+    {{- with .Project.EndDate}} · End: {{date . $.Location}}{{end}}
+    · Generated {{datetime .GeneratedAt .Location}}
+  </p>
+  {{with .Project.Description}}<p>{{.}}</p>{{end}}
+</header>
+
+<section id="progress">
+  <h2>Progress</h2>
+  <p>{{.Completed}} of {{.Total}} tasks completed ({{.Percentage}}%)</p>
+  <div class="bar"><span style="width: {{.Percentage}}%"></span></div>
+</section>
+
+<section id="lists">
+  <h2>Lists</h2>
+  {{range .Lists}}
+  <h3><span class="swatch" style="background: {{.Color}}"></span>{{.Name}} <span class="meta">({{.Completed}}/{{len .Tasks}} done)</span></h3>
+  {{if .Tasks}}
+  <table>
+    <thead><tr><th>Task</th><th>Status</th><th>Priority</th><th>Due</th><th>Completed</th></tr></thead>
+    <tbody>
+    {{range .Tasks}}
+      <tr>
+        <td{{if or .IsCompleted (eq .Status "completed")}} class="done"{{end}}>{{.Title}}</td>
+        <td>{{.Status}}</td>
+        <td>{{text .Priority}}</td>
+        <td>{{date .DueDate $.Location}}</td>
+        <td>{{date .CompletedAt $.Location}}</td>
+      </tr>
+    {{end}}
+    </tbody>
+  </table>
+  {{else}}
+  <p class="meta">No tasks.</p>
+  {{end}}
+  {{else}}
+  <p class="meta">This project has no lists.</p>
+  {{end}}
+</section>
+
+<section id="activity">
+  <h2>Recent activity</h2>
+  {{if .Activity}}
+  <table>
+    <thead><tr><th>When</th><th>Task</th><th>Field</th><th>From</th><th>To</th></tr></thead>
+    <tbody>
+    {{range .Activity}}
+      <tr>
+        <td>{{datetime .ChangedAt $.Location}}</td>
+        <td>{{.TaskTitle}}</td>
+        <td>{{.Field}}</td>
+        <td>{{text .OldValue}}</td>
+        <td>{{text .NewValue}}</td>
+      </tr>
+    {{end}}
+    </tbody>
+  </table>
+  {{else}}
+  <p class="meta">No recorded changes.</p>
+  {{end}}
+</section>
+</body>
+</html>
+`))
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+)
+
+func TestGetProjectReport(t *testing.T) {
+	f := newProjectServiceFixture()
+	project := f.store.addProject("Launch")
+	at := func(value string) *time.Time {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &parsed
+	}
+
+	f.projects.board = &models.ProjectWithListsResponse{
+		ProjectResponse: models.ProjectResponse{
+			ProjectUID: project.ProjectUID,
+			Name:       "Launch <script>alert(1)</script>",
+			Status:     "active",
+			StartDate:  at("2026-03-01T02:00:00Z"),
+		},
+		Lists: []models.ListWithTasksResponse{
+			{
+				ListResponse: models.ListResponse{Name: "Doing & Review", Color: "#FDE68A"},
+				Tasks: []models.TaskResponse{
+					{Title: "Draft", Status: "todo", Priority: strPtr("high"), DueDate: at("2026-03-10T03:00:00Z")},
+					{Title: "Ship", Status: "completed"},
+				},
+			},
+			{
+				ListResponse: models.ListResponse{Name: "Done", Color: "#BBF7D0"},
+				Tasks: []models.TaskResponse{
+					{Title: "Kickoff", Status: "todo", IsCompleted: true, CompletedAt: at("2026-03-02T12:00:00Z")},
+				},
+			},
+			{ListResponse: models.ListResponse{Name: "Later"}, Tasks: []models.TaskResponse{}},
+		},
+	}
+	f.history.recent = []models.TaskActivity{{
+		TaskHistory: models.TaskHistory{Field: "status", OldValue: strPtr("todo"), NewValue: strPtr("completed"), ChangedAt: *at("2026-03-10T15:04:00Z")},
+		TaskTitle:   "Kickoff",
+	}}
+
+	report, err := f.service.GetProjectReport(context.Background(), project.ProjectUID, newYork)
+	if err != nil {
+		t.Fatalf("GetProjectReport: %v", err)
+	}
+	html := string(report)
+
+	if f.history.recentLimit != reportActivityLimit {
+		t.Errorf("expected %d activity entries to be requested, got %d", reportActivityLimit, f.history.recentLimit)
+	}
+
+	for _, want := range []string{
+		"Launch &lt;script&gt;alert(1)&lt;/script&gt;",
+		"Start: 2026-02-28",
+		"Doing &amp; Review",
+		"(1/2 done)",
+		"(1/1 done)",
+		"2 of 3 tasks completed (66%)",
+		`<td class="done">Ship</td>`,
+		`<td class="done">Kickoff</td>`,
+		"<td>Draft</td>",
+		"<td>high</td>",
+		"<td>2026-03-09</td>",
+		"No tasks.",
+		"<td>2026-03-10 10:04 UTC-5</td>",
+		"<td>status</td>",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("expected the report to contain %q", want)
+		}
+	}
+	if strings.Contains(html, "<script>") {
+		t.Error("expected the project name to be escaped")
+	}
+}
+
+func TestGetProjectReportEmptyProject(t *testing.T) {
+	f := newProjectServiceFixture()
+	project := f.store.addProject("Launch")
+
+	report, err := f.service.GetProjectReport(context.Background(), project.ProjectUID, time.UTC)
+	if err != nil {
+		t.Fatalf("GetProjectReport: %v", err)
+	}
+	html := string(report)
+
+	for _, want := range []string{"0 of 0 tasks completed (0%)", "This project has no lists.", "No recorded changes."} {
+		if !strings.Contains(html, want) {
+			t.Errorf("expected the report to contain %q", want)
+		}
+	}
+}
+
+func TestGetProjectReportUnknownProject(t *testing.T) {
+	f := newProjectServiceFixture()
+
+	_, err := f.service.GetProjectReport(context.Background(), uuid.New(), time.UTC)
+	assertAppError(t, err, http.StatusNotFound)
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/internal/utils"
+)
+
+// maxProjectSnapshots is how many state snapshots are kept per project; older ones are pruned
+const maxProjectSnapshots = 20
+
+// CreateSnapshot captures the project's current lists and tasks, including archived tasks
+func (s *ProjectService) CreateSnapshot(ctx context.Context, uid uuid.UUID) (*models.ProjectSnapshotResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	lists, err := s.listRepo.GetByProjectID(ctx, project.ID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get lists")
+	}
+
+	tasks, err := s.taskRepo.GetByProjectID(ctx, project.ID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get tasks")
+	}
+
+	tasksByList := make(map[int][]models.ProjectStateTask)
+	for _, task := range tasks {
+		tasksByList[task.ListID] = append(tasksByList[task.ListID], models.ProjectStateTask{
+			TaskUID:     task.TaskUID,
+			Title:       task.Title,
+			Description: task.Description,
+			Priority:    task.Priority,
+			Status:      task.Status,
+			Color:       task.Color,
+			Position:    task.Position,
+			IsCompleted: task.IsCompleted,
+			DueDate:     task.DueDate,
+			CompletedAt: task.CompletedAt,
+			ArchivedAt:  task.ArchivedAt,
+			Recurrence:  task.Recurrence,
+		})
+	}
+
+	state := models.ProjectState{Lists: make([]models.ProjectStateList, 0, len(lists))}
+	for _, list := range lists {
+		listTasks := tasksByList[list.ID]
+		if listTasks == nil {
+			listTasks = []models.ProjectStateTask{}
+		}
+		state.Lists = append(state.Lists, models.ProjectStateList{
+			ListUID:  list.ListUID,
+			Name:     list.Name,
+			Color:    list.Color,
+			Position: list.Position,
+			Tasks:    listTasks,
+		})
+	}
+
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to serialize project state")
+	}
+
+	snapshot := &models.ProjectStateSnapshot{
+		SnapshotUID: uuid.New(),
+		ProjectID:   project.ID,
+		State:       raw,
+		ListCount:   len(lists),
+		TaskCount:   len(tasks),
+	}
+
+	if err := s.stateRepo.Create(ctx, snapshot, maxProjectSnapshots); err != nil {
+		return nil, utils.NewInternalError("Failed to create snapshot")
+	}
+
+	response := newProjectSnapshotResponse(snapshot)
+	return &response, nil
+}
+
+// GetSnapshots returns the project's stored snapshots, newest first
+func (s *ProjectService) GetSnapshots(ctx context.Context, uid uuid.UUID) ([]models.ProjectSnapshotResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	snapshots, err := s.stateRepo.GetByProjectID(ctx, project.ID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get snapshots")
+	}
+
+	response := []models.ProjectSnapshotResponse{}
+	for i := range snapshots {
+		response = append(response, newProjectSnapshotResponse(&snapshots[i]))
+	}
+
+	return response, nil
+}
+
+// RestoreSnapshot replaces the project's current lists and tasks with the ones captured in the snapshot
+func (s *ProjectService) RestoreSnapshot(ctx context.Context, uid, snapshotUID uuid.UUID) (*models.ProjectWithListsResponse, error) {
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	snapshot, err := s.stateRepo.GetByUID(ctx, project.ID, snapshotUID)
+	if err != nil {
+		if err.Error() == "snapshot not found" {
+			return nil, utils.NewNotFoundError("Snapshot not found")
+		}
+		return nil, utils.NewInternalError("Failed to get snapshot")
+	}
+
+	var state models.ProjectState
+	if err := json.Unmarshal(snapshot.State, &state); err != nil {
+		return nil, utils.NewInternalError("Failed to read snapshot")
+	}
+
+	if err := s.stateRepo.Restore(ctx, project.ID, &state); err != nil {
+		return nil, utils.NewInternalError("Failed to restore snapshot")
+	}
+
+	return s.GetProjectWithLists(ctx, uid, false)
+}
+
+func newProjectSnapshotResponse(snapshot *models.ProjectStateSnapshot) models.ProjectSnapshotResponse {
+	return models.ProjectSnapshotResponse{
+		SnapshotUID: snapshot.SnapshotUID,
+		ListCount:   snapshot.ListCount,
+		TaskCount:   snapshot.TaskCount,
+		CreatedAt:   snapshot.CreatedAt,
+	}
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	f := newProjectServiceFixture()
+	ctx := context.Background()
+	project := f.store.addProject("Launch")
+	todo := f.store.addList(project, "To do")
+	f.store.addTask(todo, "Draft")
+	review := f.store.addTask(todo, "Review")
+
+	snapshot, err := f.service.CreateSnapshot(ctx, project.ProjectUID)
+	if err != nil {
+		t.Fatalf("CreateSnapshot: %v", err)
+	}
+	if snapshot.ListCount != 1 || snapshot.TaskCount != 2 {
+		t.Errorf("expected 1 list and 2 tasks in the snapshot, got %+v", snapshot)
+	}
+
+	// Change the board after the snapshot was taken
+	review.Title = "Review again"
+	review.IsActive = false
+	f.store.addTask(f.store.addList(project, "Done"), "Ship")
+
+	snapshots, err := f.service.GetSnapshots(ctx, project.ProjectUID)
+	if err != nil {
+		t.Fatalf("GetSnapshots: %v", err)
+	}
+	if len(snapshots) != 1 || snapshots[0].SnapshotUID != snapshot.SnapshotUID {
+		t.Fatalf("expected the created snapshot to be listed, got %+v", snapshots)
+	}
+
+	board, err := f.service.RestoreSnapshot(ctx, project.ProjectUID, snapshot.SnapshotUID)
+	if err != nil {
+		t.Fatalf("RestoreSnapshot: %v", err)
+	}
+	if len(board.Lists) != 1 || board.Lists[0].Name != "To do" {
+		t.Fatalf("expected only the snapshot's list after restoring, got %+v", board.Lists)
+	}
+
+	var titles []string
+	for _, task := range board.Lists[0].Tasks {
+		titles = append(titles, task.Title)
+	}
+	assertTitles(t, titles, "Draft", "Review")
+	if board.Lists[0].Tasks[1].TaskUID != review.TaskUID {
+		t.Errorf("expected the restored task to keep its uid")
+	}
+}
+
+func TestSnapshotsUnknownProject(t *testing.T) {
+	f := newProjectServiceFixture()
+	ctx := context.Background()
+
+	_, err := f.service.CreateSnapshot(ctx, uuid.New())
+	assertAppError(t, err, http.StatusNotFound)
+
+	_, err = f.service.GetSnapshots(ctx, uuid.New())
+	assertAppError(t, err, http.StatusNotFound)
+
+	_, err = f.service.RestoreSnapshot(ctx, uuid.New(), uuid.New())
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestRestoreSnapshotUnknownSnapshot(t *testing.T) {
+	f := newProjectServiceFixture()
+	ctx := context.Background()
+	project := f.store.addProject("Launch")
+	other := f.store.addProject("Other")
+
+	snapshot, err := f.service.CreateSnapshot(ctx, other.ProjectUID)
+	if err != nil {
+		t.Fatalf("CreateSnapshot: %v", err)
+	}
+
+	_, err = f.service.RestoreSnapshot(ctx, project.ProjectUID, uuid.New())
+	assertAppError(t, err, http.StatusNotFound)
+
+	_, err = f.service.RestoreSnapshot(ctx, project.ProjectUID, snapshot.SnapshotUID)
+	assertAppError(t, err, http.StatusNotFound)
+}
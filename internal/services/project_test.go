@@ -0,0 +1,591 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/internal/utils"
+)
+
+// newYork is a fixed UTC-5 zone, standing in for a client west of UTC
+var newYork = time.FixedZone("UTC-5", -5*60*60)
+
+func setDueDate(t *testing.T, f *projectServiceFixture, list string, title string, due string) {
+	t.Helper()
+
+	dueDate, err := time.Parse(time.RFC3339, due)
+	if err != nil {
+		t.Fatalf("parse %q: %v", due, err)
+	}
+
+	for _, l := range f.store.lists {
+		if l.Name == list {
+			task := f.store.addTask(l, title)
+			dueDate = dueDate.UTC()
+			task.DueDate = &dueDate
+			return
+		}
+	}
+	t.Fatalf("list %q not found", list)
+}
+
+func TestGetTaskCalendarGroupsDaysInTimezone(t *testing.T) {
+	f := newProjectServiceFixture()
+	project := f.store.addProject("Launch")
+	f.store.addList(project, "Todo")
+
+	setDueDate(t, f, "Todo", "Evening before", "2026-03-09T02:00:00Z")
+	setDueDate(t, f, "Todo", "Monday morning", "2026-03-09T15:00:00Z")
+	setDueDate(t, f, "Todo", "Tuesday morning", "2026-03-10T12:00:00Z")
+	setDueDate(t, f, "Todo", "Tuesday night", "2026-03-11T03:00:00Z")
+	setDueDate(t, f, "Todo", "Wednesday", "2026-03-11T06:00:00Z")
+
+	start, end, err := utils.ParseDateRange("2026-03-09", "2026-03-10", newYork, 62)
+	if err != nil {
+		t.Fatalf("ParseDateRange: %v", err)
+	}
+
+	days, err := f.service.GetTaskCalendar(context.Background(), project.ProjectUID, start, end, newYork)
+	if err != nil {
+		t.Fatalf("GetTaskCalendar: %v", err)
+	}
+
+	want := map[string][]string{
+		"2026-03-09": {"Monday morning"},
+		"2026-03-10": {"Tuesday morning", "Tuesday night"},
+	}
+	if len(days) != len(want) {
+		t.Fatalf("expected %d days, got %+v", len(want), days)
+	}
+	for _, day := range days {
+		titles := make([]string, len(day.Tasks))
+		for i, task := range day.Tasks {
+			titles[i] = task.Title
+		}
+		assertTitles(t, titles, want[day.Date]...)
+	}
+	if days[0].Date != "2026-03-09" || days[1].Date != "2026-03-10" {
+		t.Errorf("expected days in order, got %s, %s", days[0].Date, days[1].Date)
+	}
+}
+
+func TestGetTaskCalendarUnknownProject(t *testing.T) {
+	f := newProjectServiceFixture()
+	start := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+
+	_, err := f.service.GetTaskCalendar(context.Background(), uuid.New(), start, start.AddDate(0, 0, 1), time.UTC)
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestGetDueDaysCountsDaysInTimezone(t *testing.T) {
+	f := newProjectServiceFixture()
+	project := f.store.addProject("Launch")
+	f.store.addList(project, "Todo")
+	f.store.addList(project, "Done")
+
+	setDueDate(t, f, "Todo", "Evening before", "2026-03-09T02:00:00Z")
+	setDueDate(t, f, "Todo", "Monday morning", "2026-03-09T15:00:00Z")
+	setDueDate(t, f, "Done", "Monday lunch", "2026-03-09T17:00:00Z")
+	setDueDate(t, f, "Todo", "Tuesday night", "2026-03-11T03:00:00Z")
+	setDueDate(t, f, "Done", "Thursday", "2026-03-12T15:00:00Z")
+	setDueDate(t, f, "Todo", "Friday", "2026-03-13T06:00:00Z")
+
+	archivedAt := time.Now()
+	f.store.tasks[len(f.store.tasks)-2].ArchivedAt = &archivedAt
+
+	start, end, err := utils.ParseDateRange("2026-03-09", "2026-03-12", newYork, 62)
+	if err != nil {
+		t.Fatalf("ParseDateRange: %v", err)
+	}
+
+	days, err := f.service.GetDueDays(context.Background(), project.ProjectUID, start, end, newYork)
+	if err != nil {
+		t.Fatalf("GetDueDays: %v", err)
+	}
+
+	if len(days) != 2 {
+		t.Fatalf("expected 2 days, got %+v", days)
+	}
+	if days[0].Date != "2026-03-09" || days[0].Count != 2 {
+		t.Errorf("expected 2 tasks on 2026-03-09, got %+v", days[0])
+	}
+	if days[1].Date != "2026-03-10" || days[1].Count != 1 {
+		t.Errorf("expected 1 task on 2026-03-10, got %+v", days[1])
+	}
+}
+
+func TestGetDueDaysUnknownProject(t *testing.T) {
+	f := newProjectServiceFixture()
+	start := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+
+	_, err := f.service.GetDueDays(context.Background(), uuid.New(), start, start.AddDate(0, 0, 1), time.UTC)
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestGetStatusCounts(t *testing.T) {
+	f := newProjectServiceFixture()
+	f.store.addProject("Alpha")
+	f.store.addProject("Beta")
+	f.store.addProject("Gamma").Status = "completed"
+	f.store.addProject("Deleted").IsActive = false
+
+	counts, err := f.service.GetStatusCounts(context.Background())
+	if err != nil {
+		t.Fatalf("GetStatusCounts: %v", err)
+	}
+
+	if counts.Active != 2 || counts.Completed != 1 || counts.Inactive != 0 {
+		t.Errorf("unexpected counts: %+v", counts)
+	}
+}
+
+func TestArchiveCompletedTasks(t *testing.T) {
+	f := newProjectServiceFixture()
+	project := f.store.addProject("Launch")
+	list := f.store.addList(project, "Done")
+	f.store.addTask(list, "Done one").IsCompleted = true
+	f.store.addTask(list, "Done two").IsCompleted = true
+	f.store.addTask(list, "Open")
+
+	other := f.store.addList(f.store.addProject("Other"), "Done")
+	f.store.addTask(other, "Elsewhere").IsCompleted = true
+
+	result, err := f.service.ArchiveCompletedTasks(context.Background(), project.ProjectUID)
+	if err != nil {
+		t.Fatalf("ArchiveCompletedTasks: %v", err)
+	}
+	if result.Archived != 2 {
+		t.Errorf("expected 2 archived tasks, got %d", result.Archived)
+	}
+
+	board, err := f.service.GetProjectWithLists(context.Background(), project.ProjectUID, false)
+	if err != nil {
+		t.Fatalf("GetProjectWithLists: %v", err)
+	}
+	if tasks := board.Lists[0].Tasks; len(tasks) != 1 || tasks[0].Title != "Open" {
+		t.Errorf("expected only the open task on the board, got %+v", tasks)
+	}
+
+	result, err = f.service.ArchiveCompletedTasks(context.Background(), project.ProjectUID)
+	if err != nil {
+		t.Fatalf("ArchiveCompletedTasks: %v", err)
+	}
+	if result.Archived != 0 {
+		t.Errorf("expected a second run to archive nothing, got %d", result.Archived)
+	}
+}
+
+func TestArchiveCompletedTasksUnknownProject(t *testing.T) {
+	f := newProjectServiceFixture()
+
+	_, err := f.service.ArchiveCompletedTasks(context.Background(), uuid.New())
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestCheckNameAvailable(t *testing.T) {
+	f := newProjectServiceFixture()
+	f.store.addProject("Launch Plan")
+	f.store.addProject("Old Plan").IsActive = false
+
+	tests := []struct {
+		name      string
+		available bool
+	}{
+		{"Launch Plan", false},
+		{"  launch plan ", false},
+		{"Old Plan", true},
+		{"Roadmap", true},
+	}
+
+	for _, tt := range tests {
+		result, err := f.service.CheckNameAvailable(context.Background(), tt.name)
+		if err != nil {
+			t.Fatalf("CheckNameAvailable(%q): %v", tt.name, err)
+		}
+		if result.Available != tt.available {
+			t.Errorf("CheckNameAvailable(%q) = %v, expected %v", tt.name, result.Available, tt.available)
+		}
+	}
+}
+
+func TestCheckNameAvailableBlankName(t *testing.T) {
+	f := newProjectServiceFixture()
+
+	for _, name := range []string{"", "   "} {
+		_, err := f.service.CheckNameAvailable(context.Background(), name)
+		assertAppError(t, err, http.StatusBadRequest)
+	}
+}
+
+func TestGetBoardSummaryUnknownProject(t *testing.T) {
+	f := newProjectServiceFixture()
+
+	_, err := f.service.GetBoardSummary(context.Background(), uuid.New())
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestGetTasksWithoutDueDate(t *testing.T) {
+	f := newProjectServiceFixture()
+	project := f.store.addProject("Launch")
+	f.tasks.queryTasks = []models.Task{{TaskUID: uuid.New(), Title: "Draft"}, {TaskUID: uuid.New(), Title: "Review"}}
+
+	tasks, err := f.service.GetTasksWithoutDueDate(context.Background(), project.ProjectUID)
+	if err != nil {
+		t.Fatalf("GetTasksWithoutDueDate: %v", err)
+	}
+
+	if len(f.tasks.queries) != 1 {
+		t.Fatalf("expected one query, got %d", len(f.tasks.queries))
+	}
+	filter := f.tasks.queries[0]
+	if filter.IsCompleted == nil || *filter.IsCompleted || filter.HasDueDate == nil || *filter.HasDueDate {
+		t.Errorf("expected incomplete tasks without a due date, got %+v", filter)
+	}
+
+	var titles []string
+	for _, task := range tasks {
+		titles = append(titles, task.Title)
+	}
+	assertTitles(t, titles, "Draft", "Review")
+}
+
+func TestGetTasksWithoutDueDateUnknownProject(t *testing.T) {
+	f := newProjectServiceFixture()
+
+	_, err := f.service.GetTasksWithoutDueDate(context.Background(), uuid.New())
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestGetColorUsageUnknownProject(t *testing.T) {
+	f := newProjectServiceFixture()
+
+	_, err := f.service.GetColorUsage(context.Background(), uuid.New())
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestIntegrityUnknownProject(t *testing.T) {
+	f := newProjectServiceFixture()
+
+	_, err := f.service.GetIntegrityReport(context.Background(), uuid.New())
+	assertAppError(t, err, http.StatusNotFound)
+
+	_, err = f.service.RepairIntegrity(context.Background(), uuid.New())
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestReorderBoardReturnsTheBoard(t *testing.T) {
+	f := newProjectServiceFixture()
+	project := f.store.addProject("Launch")
+	todo := f.store.addList(project, "To do")
+	draft := f.store.addTask(todo, "Draft")
+
+	lists := []models.BoardReorderList{{ListUID: todo.ListUID, TaskUIDs: []uuid.UUID{draft.TaskUID}}}
+	board, err := f.service.ReorderBoard(context.Background(), project.ProjectUID, &models.BoardReorderRequest{Lists: lists})
+	if err != nil {
+		t.Fatalf("ReorderBoard: %v", err)
+	}
+
+	if len(f.projects.reorders) != 1 || !reflect.DeepEqual(f.projects.reorders[0], lists) {
+		t.Errorf("expected the lists to be passed through, got %+v", f.projects.reorders)
+	}
+	if board.ProjectUID != project.ProjectUID || len(board.Lists) != 1 || len(board.Lists[0].Tasks) != 1 {
+		t.Errorf("expected the reordered board, got %+v", board)
+	}
+}
+
+func TestReorderBoardRejectsDuplicates(t *testing.T) {
+	f := newProjectServiceFixture()
+	project := f.store.addProject("Launch")
+	list := f.store.addList(project, "To do")
+	task := f.store.addTask(list, "Draft")
+
+	tests := []struct {
+		name  string
+		lists []models.BoardReorderList
+	}{
+		{"duplicate list", []models.BoardReorderList{{ListUID: list.ListUID}, {ListUID: list.ListUID}}},
+		{"duplicate task", []models.BoardReorderList{{ListUID: list.ListUID, TaskUIDs: []uuid.UUID{task.TaskUID, task.TaskUID}}}},
+		{"task in two lists", []models.BoardReorderList{
+			{ListUID: list.ListUID, TaskUIDs: []uuid.UUID{task.TaskUID}},
+			{ListUID: uuid.New(), TaskUIDs: []uuid.UUID{task.TaskUID}},
+		}},
+	}
+
+	for _, tt := range tests {
+		_, err := f.service.ReorderBoard(context.Background(), project.ProjectUID, &models.BoardReorderRequest{Lists: tt.lists})
+		t.Run(tt.name, func(t *testing.T) {
+			assertAppError(t, err, http.StatusBadRequest)
+		})
+	}
+
+	if len(f.projects.reorders) != 0 {
+		t.Errorf("expected duplicates to be rejected before reaching the repository, got %d calls", len(f.projects.reorders))
+	}
+}
+
+func TestReorderBoardMapsRepositoryErrors(t *testing.T) {
+	tests := []struct {
+		err        error
+		statusCode int
+	}{
+		{fmt.Errorf("list not found"), http.StatusBadRequest},
+		{fmt.Errorf("task not found"), http.StatusBadRequest},
+		{fmt.Errorf("failed to reorder board: connection reset"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		f := newProjectServiceFixture()
+		project := f.store.addProject("Launch")
+		f.projects.reorderErr = tt.err
+
+		_, err := f.service.ReorderBoard(context.Background(), project.ProjectUID, &models.BoardReorderRequest{
+			Lists: []models.BoardReorderList{{ListUID: uuid.New()}},
+		})
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			assertAppError(t, err, tt.statusCode)
+		})
+	}
+}
+
+func TestBoardReorderRequestValidation(t *testing.T) {
+	if err := utils.ValidateStruct(&models.BoardReorderRequest{}); err == nil {
+		t.Error("expected missing lists to be rejected")
+	}
+	if err := utils.ValidateStruct(&models.BoardReorderRequest{Lists: []models.BoardReorderList{{}}}); err == nil {
+		t.Error("expected a missing list_uid to be rejected")
+	}
+}
+
+func TestReorderBoardUnknownProject(t *testing.T) {
+	f := newProjectServiceFixture()
+
+	_, err := f.service.ReorderBoard(context.Background(), uuid.New(), &models.BoardReorderRequest{
+		Lists: []models.BoardReorderList{{ListUID: uuid.New()}},
+	})
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestRestoreProject(t *testing.T) {
+	f := newProjectServiceFixture()
+	project := f.store.addProject("Launch")
+
+	restored, err := f.service.RestoreProject(context.Background(), project.ProjectUID)
+	if err != nil {
+		t.Fatalf("RestoreProject: %v", err)
+	}
+
+	if !reflect.DeepEqual(f.projects.restores, []uuid.UUID{project.ProjectUID}) {
+		t.Errorf("expected the project to be restored, got %v", f.projects.restores)
+	}
+	if restored.ProjectUID != project.ProjectUID || restored.Name != "Launch" {
+		t.Errorf("expected the restored project, got %+v", restored)
+	}
+}
+
+func TestRestoreProjectMapsRepositoryErrors(t *testing.T) {
+	tests := []struct {
+		err        error
+		statusCode int
+	}{
+		{fmt.Errorf("project not found"), http.StatusNotFound},
+		{fmt.Errorf("failed to restore project: connection reset"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		f := newProjectServiceFixture()
+		f.projects.restoreErr = tt.err
+
+		_, err := f.service.RestoreProject(context.Background(), uuid.New())
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			assertAppError(t, err, tt.statusCode)
+		})
+	}
+}
+
+func TestCloneProjectResetsCompletionAndSkipsArchivedTasks(t *testing.T) {
+	f := newProjectServiceFixture()
+	source := f.store.addProject("Launch")
+	list := f.store.addList(source, "Doing")
+	open := f.store.addTask(list, "Draft")
+	open.Status = "in_progress"
+	done := f.store.addTask(list, "Review")
+	completedAt := time.Now()
+	done.Status, done.IsCompleted, done.CompletedAt = "completed", true, &completedAt
+	archived := f.store.addTask(list, "Old")
+	archived.ArchivedAt = &completedAt
+
+	clone, err := f.service.CloneProject(context.Background(), source.ProjectUID, nil)
+	if err != nil {
+		t.Fatalf("CloneProject: %v", err)
+	}
+	if clone.Name != "Launch (copy)" || clone.ProjectUID == source.ProjectUID || len(clone.Lists) != 1 {
+		t.Fatalf("unexpected clone: %+v", clone)
+	}
+
+	// Read the stored copies rather than the response, which leaves archived tasks out anyway
+	var copied []*models.Task
+	for _, l := range f.store.lists {
+		if l.ListUID == clone.Lists[0].ListUID {
+			copied = f.store.tasksInList(l.ID)
+		}
+	}
+
+	want := map[string]string{"Draft": "in_progress", "Review": "todo"}
+	if len(copied) != len(want) {
+		t.Fatalf("expected %d copied tasks, got %d", len(want), len(copied))
+	}
+	for _, task := range copied {
+		if status, ok := want[task.Title]; !ok || task.Status != status {
+			t.Errorf("task %q: expected status %q, got %q", task.Title, status, task.Status)
+		}
+		if task.IsCompleted || task.CompletedAt != nil {
+			t.Errorf("task %q: expected completion to be reset", task.Title)
+		}
+		if task.TaskUID == open.TaskUID || task.TaskUID == done.TaskUID {
+			t.Errorf("task %q: expected a new uid", task.Title)
+		}
+	}
+
+	if !done.IsCompleted || done.Status != "completed" {
+		t.Error("expected the source task to stay completed")
+	}
+}
+
+func TestReorderProjectsRejectsDuplicates(t *testing.T) {
+	f := newProjectServiceFixture()
+	uid := uuid.New()
+
+	_, err := f.service.ReorderProjects(context.Background(), &models.ProjectReorderRequest{
+		Items: []models.ProjectReorderItem{{ProjectUID: uid, Position: 1}, {ProjectUID: uid, Position: 2}},
+	})
+	assertAppError(t, err, http.StatusBadRequest)
+
+	if len(f.projects.positions) != 0 {
+		t.Errorf("expected duplicates to be rejected before reaching the repository, got %d calls", len(f.projects.positions))
+	}
+}
+
+func TestReorderProjectsMapsRepositoryErrors(t *testing.T) {
+	tests := []struct {
+		err        error
+		statusCode int
+	}{
+		{fmt.Errorf("project not found"), http.StatusNotFound},
+		{fmt.Errorf("failed to update project positions: connection reset"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		f := newProjectServiceFixture()
+		f.projects.positionsErr = tt.err
+		first, second := uuid.New(), uuid.New()
+
+		_, err := f.service.ReorderProjects(context.Background(), &models.ProjectReorderRequest{
+			Items: []models.ProjectReorderItem{{ProjectUID: first, Position: 1}, {ProjectUID: second, Position: 2}},
+		})
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			assertAppError(t, err, tt.statusCode)
+		})
+
+		want := []map[uuid.UUID]int{{first: 1, second: 2}}
+		if !reflect.DeepEqual(f.projects.positions, want) {
+			t.Errorf("expected positions %v, got %v", want, f.projects.positions)
+		}
+	}
+}
+
+func TestGetAllProjectsIncludeCounts(t *testing.T) {
+	f := newProjectServiceFixture()
+	launch := f.store.addProject("Launch")
+	empty := f.store.addProject("Empty")
+	f.projects.counts = map[int]models.ProjectCounts{launch.ID: {ListCount: 3, TaskCount: 7}}
+
+	projects, err := f.service.GetAllProjects(context.Background(), ProjectListOptions{IncludeCounts: true})
+	if err != nil {
+		t.Fatalf("GetAllProjects: %v", err)
+	}
+
+	want := map[uuid.UUID][2]int{launch.ProjectUID: {3, 7}, empty.ProjectUID: {0, 0}}
+	if len(projects) != len(want) {
+		t.Fatalf("expected %d projects, got %d", len(want), len(projects))
+	}
+	for _, project := range projects {
+		if project.ListCount == nil || project.TaskCount == nil {
+			t.Errorf("project %q: expected counts to be set", project.Name)
+			continue
+		}
+		if got := [2]int{*project.ListCount, *project.TaskCount}; got != want[project.ProjectUID] {
+			t.Errorf("project %q: expected list and task counts %v, got %v", project.Name, want[project.ProjectUID], got)
+		}
+	}
+}
+
+func TestGetAllProjectsWithoutCounts(t *testing.T) {
+	f := newProjectServiceFixture()
+	f.store.addProject("Launch")
+
+	projects, err := f.service.GetAllProjects(context.Background(), ProjectListOptions{})
+	if err != nil {
+		t.Fatalf("GetAllProjects: %v", err)
+	}
+
+	if len(projects) != 1 || projects[0].ListCount != nil || projects[0].TaskCount != nil {
+		t.Errorf("expected one project without counts, got %+v", projects)
+	}
+	if f.projects.countCalls != 0 {
+		t.Errorf("expected counts not to be queried, got %d calls", f.projects.countCalls)
+	}
+}
+
+func TestGetOverdueTasksCutsOffAtStartOfDayInTimezone(t *testing.T) {
+	startOfDay := func(at time.Time, loc *time.Location) time.Time {
+		at = at.In(loc)
+		return time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, loc).UTC()
+	}
+
+	for _, loc := range []*time.Location{time.UTC, newYork, time.FixedZone("UTC+14", 14*60*60)} {
+		t.Run(loc.String(), func(t *testing.T) {
+			f := newProjectServiceFixture()
+			project := f.store.addProject("Launch")
+			f.tasks.overdueTasks = []models.Task{{TaskUID: uuid.New(), Title: "Late", Status: "todo"}}
+
+			earliest := startOfDay(time.Now(), loc)
+			overdue, err := f.service.GetOverdueTasks(context.Background(), project.ProjectUID, loc)
+			latest := startOfDay(time.Now(), loc)
+			if err != nil {
+				t.Fatalf("GetOverdueTasks: %v", err)
+			}
+
+			if len(f.tasks.overdueBefore) != 1 {
+				t.Fatalf("expected one query, got %d", len(f.tasks.overdueBefore))
+			}
+			before := f.tasks.overdueBefore[0]
+			if before.Location() != time.UTC || (!before.Equal(earliest) && !before.Equal(latest)) {
+				t.Errorf("expected the start of today in %s as UTC (%v), got %v", loc, earliest, before)
+			}
+			if overdue.Count != 1 || len(overdue.Tasks) != 1 || overdue.Tasks[0].Title != "Late" {
+				t.Errorf("expected the repository tasks, got %+v", overdue)
+			}
+		})
+	}
+}
+
+func TestGetAllProjectsHidesArchivedByDefault(t *testing.T) {
+	f := newProjectServiceFixture()
+
+	for _, opts := range []ProjectListOptions{{}, {IncludeArchived: true}} {
+		if _, err := f.service.GetAllProjects(context.Background(), opts); err != nil {
+			t.Fatalf("GetAllProjects: %v", err)
+		}
+	}
+
+	if want := []bool{false, true}; !reflect.DeepEqual(f.projects.includeArchived, want) {
+		t.Errorf("expected includeArchived %v, got %v", want, f.projects.includeArchived)
+	}
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/pkg/logger"
+)
+
+// spawnNextOccurrence creates the next instance of a recurring task that was just completed.
+// The completed task is kept as is. Like history, this is best effort: failures are logged
+// but do not fail the update that completed the task.
+func (s *TaskService) spawnNextOccurrence(ctx context.Context, before, after *models.Task) {
+	if after.Recurrence == nil || !justCompleted(before, after) {
+		return
+	}
+
+	// Advance from the due date, or from the completion time for tasks without one
+	base := time.Now()
+	if after.DueDate != nil {
+		base = *after.DueDate
+	} else if after.CompletedAt != nil {
+		base = *after.CompletedAt
+	}
+
+	dueDate, err := nextDueDate(base, *after.Recurrence)
+	if err != nil {
+		logRecurrenceError(after.TaskUID, err)
+		return
+	}
+
+	maxPos, err := s.taskRepo.GetMaxPositionByList(ctx, after.ListID)
+	if err != nil {
+		logRecurrenceError(after.TaskUID, err)
+		return
+	}
+	position := maxPos + 1
+
+	next := &models.Task{
+		TaskUID:     uuid.New(),
+		ListID:      after.ListID,
+		Title:       after.Title,
+		Description: after.Description,
+		Priority:    after.Priority,
+		Status:      "todo",
+		Color:       after.Color,
+		Position:    &position,
+		IsCompleted: false,
+		DueDate:     &dueDate,
+		Recurrence:  after.Recurrence,
+		IsActive:    true,
+		CreatedBy:   after.UpdatedBy,
+	}
+
+	if err := s.taskRepo.Create(ctx, next); err != nil {
+		logRecurrenceError(after.TaskUID, err)
+	}
+}
+
+// justCompleted reports whether an update moved a task into the completed state
+func justCompleted(before, after *models.Task) bool {
+	return !isTaskCompleted(before) && isTaskCompleted(after)
+}
+
+// isTaskCompleted treats a task as complete when either its flag or its status says so
+func isTaskCompleted(task *models.Task) bool {
+	return task.IsCompleted || task.Status == "completed"
+}
+
+// nextDueDate advances from by one recurrence step. Monthly and yearly steps clamp to the
+// last day of the target month, so Jan 31 becomes Feb 28 (or 29) rather than spilling into March.
+func nextDueDate(from time.Time, rule models.Recurrence) (time.Time, error) {
+	if rule.Interval < 1 {
+		return time.Time{}, fmt.Errorf("recurrence interval must be at least 1, got %d", rule.Interval)
+	}
+
+	switch rule.Freq {
+	case "daily":
+		return from.AddDate(0, 0, rule.Interval), nil
+	case "weekly":
+		return from.AddDate(0, 0, 7*rule.Interval), nil
+	case "monthly":
+		return addMonthsClamped(from, rule.Interval), nil
+	case "yearly":
+		return addMonthsClamped(from, 12*rule.Interval), nil
+	default:
+		return time.Time{}, fmt.Errorf("unsupported recurrence frequency %q", rule.Freq)
+	}
+}
+
+func addMonthsClamped(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	first := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+
+	lastDay := first.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return first.AddDate(0, 0, day-1)
+}
+
+func logRecurrenceError(taskUID uuid.UUID, err error) {
+	logger.WithComponent("task-service").
+		WithFields(map[string]interface{}{
+			"task_uid": taskUID.String(),
+			"error":    err.Error(),
+		}).
+		Error("Failed to create next occurrence of recurring task")
+}
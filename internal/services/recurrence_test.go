@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"lucid-lists-backend/internal/models"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 30, 0, 0, time.UTC)
+}
+
+func TestNextDueDate(t *testing.T) {
+	tests := []struct {
+		name string
+		from time.Time
+		rule models.Recurrence
+		want time.Time
+	}{
+		{"daily", date(2026, 3, 31), models.Recurrence{Freq: "daily", Interval: 1}, date(2026, 4, 1)},
+		{"every 3 days", date(2026, 3, 30), models.Recurrence{Freq: "daily", Interval: 3}, date(2026, 4, 2)},
+		{"weekly", date(2026, 12, 28), models.Recurrence{Freq: "weekly", Interval: 1}, date(2027, 1, 4)},
+		{"every 2 weeks", date(2026, 3, 1), models.Recurrence{Freq: "weekly", Interval: 2}, date(2026, 3, 15)},
+		{"monthly", date(2026, 3, 15), models.Recurrence{Freq: "monthly", Interval: 1}, date(2026, 4, 15)},
+		{"Jan 31 to Feb 28", date(2026, 1, 31), models.Recurrence{Freq: "monthly", Interval: 1}, date(2026, 2, 28)},
+		{"Jan 31 to Feb 29 in a leap year", date(2028, 1, 31), models.Recurrence{Freq: "monthly", Interval: 1}, date(2028, 2, 29)},
+		{"Mar 31 to Apr 30", date(2026, 3, 31), models.Recurrence{Freq: "monthly", Interval: 1}, date(2026, 4, 30)},
+		{"monthly across the year end", date(2026, 12, 31), models.Recurrence{Freq: "monthly", Interval: 2}, date(2027, 2, 28)},
+		{"yearly", date(2026, 6, 1), models.Recurrence{Freq: "yearly", Interval: 1}, date(2027, 6, 1)},
+		{"Feb 29 to Feb 28", date(2028, 2, 29), models.Recurrence{Freq: "yearly", Interval: 1}, date(2029, 2, 28)},
+		{"Feb 29 to Feb 29 four years on", date(2028, 2, 29), models.Recurrence{Freq: "yearly", Interval: 4}, date(2032, 2, 29)},
+	}
+
+	for _, tt := range tests {
+		got, err := nextDueDate(tt.from, tt.rule)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestNextDueDateRejectsBadRules(t *testing.T) {
+	tests := []struct {
+		name string
+		rule models.Recurrence
+	}{
+		{"zero interval", models.Recurrence{Freq: "daily", Interval: 0}},
+		{"negative interval", models.Recurrence{Freq: "monthly", Interval: -1}},
+		{"unknown frequency", models.Recurrence{Freq: "hourly", Interval: 1}},
+	}
+
+	for _, tt := range tests {
+		if _, err := nextDueDate(date(2026, 1, 31), tt.rule); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
+
+func TestAddMonthsClampedKeepsTimeOfDay(t *testing.T) {
+	from := time.Date(2026, 1, 31, 23, 45, 0, 0, newYork)
+
+	got := addMonthsClamped(from, 1)
+
+	want := time.Date(2026, 2, 28, 23, 45, 0, 0, newYork)
+	if !got.Equal(want) || got.Location() != newYork {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+// recurringTask adds a weekly task to a list that already holds another task
+func recurringTask(f *taskServiceFixture, due *time.Time) (*models.List, *models.Task) {
+	list := f.store.addList(f.store.addProject("Chores"), "Weekly")
+	f.store.addTask(list, "One-off")
+	task := f.store.addTask(list, "Water plants")
+	task.Priority = strPtr("medium")
+	task.Color = "#00AA00"
+	task.DueDate = due
+	task.Recurrence = &models.Recurrence{Freq: "weekly", Interval: 1}
+	return list, task
+}
+
+func TestCompletingRecurringTaskSpawnsNextOccurrence(t *testing.T) {
+	f := newTaskServiceFixture()
+	due := date(2026, 1, 31)
+	list, task := recurringTask(f, &due)
+
+	_, err := f.service.PartialUpdateTask(context.Background(), task.TaskUID, &models.TaskUpdateRequest{IsCompleted: boolPtr(true)})
+	if err != nil {
+		t.Fatalf("PartialUpdateTask: %v", err)
+	}
+
+	tasks := f.store.tasksInList(list.ID)
+	if len(tasks) != 3 {
+		t.Fatalf("expected a new occurrence in the list, got %d tasks", len(tasks))
+	}
+
+	next := tasks[2]
+	if next.TaskUID == task.TaskUID || next.Title != "Water plants" || next.IsCompleted || next.Status != "todo" {
+		t.Errorf("expected a fresh open copy, got %+v", next)
+	}
+	if want := date(2026, 2, 7); next.DueDate == nil || !next.DueDate.Equal(want) {
+		t.Errorf("expected the next due date %v, got %v", want, next.DueDate)
+	}
+	if *next.Position != 3 || *next.Priority != "medium" || next.Color != "#00AA00" || next.Recurrence == nil {
+		t.Errorf("expected the copy at the end of the list with the same fields, got %+v", next)
+	}
+	if !task.IsCompleted {
+		t.Error("expected the completed task to be kept as is")
+	}
+}
+
+func TestCompletingRecurringTaskWithoutDueDate(t *testing.T) {
+	f := newTaskServiceFixture()
+	list, task := recurringTask(f, nil)
+
+	before := time.Now()
+	_, err := f.service.PartialUpdateTask(context.Background(), task.TaskUID, &models.TaskUpdateRequest{Status: strPtr("completed")})
+	if err != nil {
+		t.Fatalf("PartialUpdateTask: %v", err)
+	}
+
+	tasks := f.store.tasksInList(list.ID)
+	if len(tasks) != 3 {
+		t.Fatalf("expected a new occurrence in the list, got %d tasks", len(tasks))
+	}
+
+	// Without a due date the next one is a week after completion
+	next := tasks[2]
+	earliest, latest := before.AddDate(0, 0, 7), time.Now().AddDate(0, 0, 7)
+	if next.DueDate == nil || next.DueDate.Before(earliest) || next.DueDate.After(latest) {
+		t.Errorf("expected a due date a week after completion, got %v", next.DueDate)
+	}
+}
+
+func TestRecurrenceOnlyOnCompletion(t *testing.T) {
+	f := newTaskServiceFixture()
+	due := date(2026, 1, 31)
+	list, task := recurringTask(f, &due)
+	plain := f.store.addTask(list, "Plain")
+
+	updates := []struct {
+		target  *models.Task
+		request models.TaskUpdateRequest
+	}{
+		{task, models.TaskUpdateRequest{Title: strPtr("Water all plants")}},
+		{plain, models.TaskUpdateRequest{IsCompleted: boolPtr(true)}},
+	}
+	for _, u := range updates {
+		if _, err := f.service.PartialUpdateTask(context.Background(), u.target.TaskUID, &u.request); err != nil {
+			t.Fatalf("PartialUpdateTask: %v", err)
+		}
+	}
+
+	// Completing an already completed task does not spawn another one either
+	task.IsCompleted = true
+	task.Status = "completed"
+	if _, err := f.service.PartialUpdateTask(context.Background(), task.TaskUID, &models.TaskUpdateRequest{IsCompleted: boolPtr(true)}); err != nil {
+		t.Fatalf("PartialUpdateTask: %v", err)
+	}
+
+	if tasks := f.store.tasksInList(list.ID); len(tasks) != 3 {
+		t.Errorf("expected no new occurrences, got %d tasks", len(tasks))
+	}
+}
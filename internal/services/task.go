@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -10,15 +12,31 @@ import (
 	"lucid-lists-backend/internal/utils"
 )
 
+// maxBulkTasks caps how many tasks a single bulk create may insert
+const maxBulkTasks = 200
+
+// overdueTasksPerProject caps how many tasks are listed for each project in the overdue summary
+const overdueTasksPerProject = 5
+
 type TaskService struct {
-	taskRepo repositories.TaskRepository
-	listRepo repositories.ListRepository
+	taskRepo       repositories.TaskRepository
+	listRepo       repositories.ListRepository
+	historyRepo    repositories.TaskHistoryRepository
+	checklistRepo  repositories.ChecklistRepository
+	defaultsRepo   repositories.TaskDefaultsRepository
+	dependencyRepo repositories.DependencyRepository
+	linkRepo       repositories.LinkRepository
 }
 
-func NewTaskService(taskRepo repositories.TaskRepository, listRepo repositories.ListRepository) *TaskService {
+func NewTaskService(taskRepo repositories.TaskRepository, listRepo repositories.ListRepository, historyRepo repositories.TaskHistoryRepository, checklistRepo repositories.ChecklistRepository, defaultsRepo repositories.TaskDefaultsRepository, dependencyRepo repositories.DependencyRepository, linkRepo repositories.LinkRepository) *TaskService {
 	return &TaskService{
-		taskRepo: taskRepo,
-		listRepo: listRepo,
+		taskRepo:       taskRepo,
+		listRepo:       listRepo,
+		historyRepo:    historyRepo,
+		checklistRepo:  checklistRepo,
+		defaultsRepo:   defaultsRepo,
+		dependencyRepo: dependencyRepo,
+		linkRepo:       linkRepo,
 	}
 }
 
@@ -42,11 +60,12 @@ func (s *TaskService) CreateTask(ctx context.Context, req *models.TaskRequest) (
 		}
 	}
 
-	// Set default color if not provided
-	color := req.Color
-	if color == "" {
-		color = "#FFFFFF"
+	// Fill omitted fields from the project's task defaults, then the global defaults
+	defaults, err := s.getTaskDefaults(ctx, list.ProjectID)
+	if err != nil {
+		return nil, err
 	}
+	priority, status, color := defaults.apply(req.Priority, req.Status, req.Color)
 
 	// Set default is_completed if not provided
 	isCompleted := false
@@ -60,20 +79,17 @@ func (s *TaskService) CreateTask(ctx context.Context, req *models.TaskRequest) (
 		ListID:      list.ID,
 		Title:       req.Title,
 		Description: req.Description,
-		Priority:    req.Priority,
-		Status:      req.Status,
+		Priority:    priority,
+		Status:      status,
 		Color:       color,
 		Position:    position,
 		IsCompleted: isCompleted,
 		DueDate:     req.DueDate,
+		Recurrence:  req.Recurrence,
 		IsActive:    true,
 		CreatedBy:   nil, // No user authentication yet
 	}
 
-	if req.Status == "" {
-		task.Status = "todo"
-	}
-
 	if err := s.taskRepo.Create(ctx, task); err != nil {
 		return nil, utils.NewInternalError("Failed to create task")
 	}
@@ -89,14 +105,77 @@ func (s *TaskService) CreateTask(ctx context.Context, req *models.TaskRequest) (
 		IsCompleted: task.IsCompleted,
 		DueDate:     task.DueDate,
 		CompletedAt: task.CompletedAt,
+		ArchivedAt:  task.ArchivedAt,
+		Recurrence:  task.Recurrence,
 		CreatedAt:   task.CreatedAt,
 		UpdatedAt:   task.UpdatedAt,
 	}, nil
 }
 
+// CreateTasksBulk creates tasks at the end of a list in request order, all or nothing
+func (s *TaskService) CreateTasksBulk(ctx context.Context, listUID uuid.UUID, req *models.BulkCreateTasksRequest) ([]models.TaskResponse, error) {
+	if len(req.Tasks) > maxBulkTasks {
+		return nil, utils.NewBadRequestError(fmt.Sprintf("At most %d tasks can be created at once", maxBulkTasks))
+	}
+
+	list, err := s.listRepo.GetByUID(ctx, listUID)
+	if err != nil {
+		if err.Error() == "list not found" {
+			return nil, utils.NewNotFoundError("List not found")
+		}
+		return nil, utils.NewInternalError("Failed to get list")
+	}
+
+	maxPos, err := s.taskRepo.GetMaxPositionByList(ctx, list.ID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get max position")
+	}
+
+	defaults, err := s.getTaskDefaults(ctx, list.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]models.Task, 0, len(req.Tasks))
+	for i, item := range req.Tasks {
+		position := maxPos + i + 1
+		priority, status, color := defaults.apply(item.Priority, item.Status, item.Color)
+
+		isCompleted := false
+		if item.IsCompleted != nil {
+			isCompleted = *item.IsCompleted
+		}
+
+		tasks = append(tasks, models.Task{
+			TaskUID:     uuid.New(),
+			ListID:      list.ID,
+			Title:       item.Title,
+			Description: item.Description,
+			Priority:    priority,
+			Status:      status,
+			Color:       color,
+			Position:    &position,
+			IsCompleted: isCompleted,
+			DueDate:     item.DueDate,
+			IsActive:    true,
+		})
+	}
+
+	if err := s.taskRepo.CreateBatch(ctx, tasks); err != nil {
+		return nil, utils.NewInternalError("Failed to create tasks")
+	}
+
+	response := make([]models.TaskResponse, 0, len(tasks))
+	for i := range tasks {
+		response = append(response, newTaskResponse(&tasks[i]))
+	}
+
+	return response, nil
+}
+
 func (s *TaskService) UpdateTask(ctx context.Context, uid uuid.UUID, req *models.TaskRequest) (*models.TaskResponse, error) {
 	// Check if task exists
-	_, err := s.taskRepo.GetByUID(ctx, uid)
+	existingTask, err := s.taskRepo.GetByUID(ctx, uid)
 	if err != nil {
 		if err.Error() == "task not found" {
 			return nil, utils.NewNotFoundError("Task not found")
@@ -104,15 +183,36 @@ func (s *TaskService) UpdateTask(ctx context.Context, uid uuid.UUID, req *models
 		return nil, utils.NewInternalError("Failed to get task")
 	}
 
+	// Status is optional on requests; keep the current one when it is omitted
+	status := req.Status
+	if status == "" {
+		status = existingTask.Status
+	}
+
+	// Likewise keep the completion state, and its original completed_at, when is_completed is omitted
+	isCompleted := existingTask.IsCompleted
+	if req.IsCompleted != nil {
+		isCompleted = *req.IsCompleted
+	}
+
+	if !isTaskCompleted(existingTask) && (isCompleted || status == "completed") {
+		if err := s.ensureDependenciesComplete(ctx, existingTask); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update task fields
 	task := &models.Task{
 		Title:       req.Title,
 		Description: req.Description,
 		Priority:    req.Priority,
-		Status:      req.Status,
+		Status:      status,
 		Color:       req.Color,
 		Position:    req.Position,
+		IsCompleted: isCompleted,
 		DueDate:     req.DueDate,
+		CompletedAt: existingTask.CompletedAt,
+		Recurrence:  req.Recurrence,
 	}
 
 	if err := s.taskRepo.Update(ctx, uid, task); err != nil {
@@ -128,19 +228,55 @@ func (s *TaskService) UpdateTask(ctx context.Context, uid uuid.UUID, req *models
 		return nil, utils.NewInternalError("Failed to get updated task")
 	}
 
-	return &models.TaskResponse{
-		TaskUID:     updatedTask.TaskUID,
-		Title:       updatedTask.Title,
-		Description: updatedTask.Description,
-		Priority:    updatedTask.Priority,
-		Status:      updatedTask.Status,
-		Color:       updatedTask.Color,
-		Position:    updatedTask.Position,
-		IsCompleted: updatedTask.IsCompleted,
-		DueDate:     updatedTask.DueDate,
-		CompletedAt: updatedTask.CompletedAt,
-		CreatedAt:   updatedTask.CreatedAt,
-		UpdatedAt:   updatedTask.UpdatedAt,
+	s.recordTaskChanges(ctx, existingTask, updatedTask)
+	s.spawnNextOccurrence(ctx, existingTask, updatedTask)
+
+	return s.taskResponseWithDependencies(ctx, updatedTask)
+}
+
+// BulkUpdatePriority sets the same priority on many tasks at once
+func (s *TaskService) BulkUpdatePriority(ctx context.Context, req *models.BulkPriorityRequest) (*models.BulkUpdateResponse, error) {
+	return s.bulkUpdateField(ctx, req.TaskUIDs, "priority", req.Priority)
+}
+
+// BulkUpdateColor sets the same color on many tasks at once
+func (s *TaskService) BulkUpdateColor(ctx context.Context, req *models.BulkColorRequest) (*models.BulkUpdateResponse, error) {
+	return s.bulkUpdateField(ctx, req.TaskUIDs, "color", req.Color)
+}
+
+// bulkUpdateField writes one field on every active task in uids in a single statement.
+// UIDs that do not match an active task are reported back as failed.
+func (s *TaskService) bulkUpdateField(ctx context.Context, uids []uuid.UUID, field string, value string) (*models.BulkUpdateResponse, error) {
+	unique := make([]uuid.UUID, 0, len(uids))
+	seen := make(map[uuid.UUID]bool, len(uids))
+	for _, uid := range uids {
+		if !seen[uid] {
+			seen[uid] = true
+			unique = append(unique, uid)
+		}
+	}
+
+	changes, err := s.taskRepo.UpdateFieldBulk(ctx, unique, field, value)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to update tasks")
+	}
+
+	updated := make(map[uuid.UUID]bool, len(changes))
+	for _, change := range changes {
+		updated[change.TaskUID] = true
+		s.recordFieldChange(ctx, change.TaskID, change.TaskUID, field, change.OldValue, &value)
+	}
+
+	failed := []uuid.UUID{}
+	for _, uid := range unique {
+		if !updated[uid] {
+			failed = append(failed, uid)
+		}
+	}
+
+	return &models.BulkUpdateResponse{
+		Updated: len(changes),
+		Failed:  failed,
 	}, nil
 }
 
@@ -166,7 +302,7 @@ func (s *TaskService) MoveTask(ctx context.Context, uid uuid.UUID, req *models.M
 	}
 
 	// Move the task
-	if err := s.taskRepo.MoveToList(ctx, uid, list.ID); err != nil {
+	if err := s.taskRepo.MoveToList(ctx, uid, list.ID, req.Position); err != nil {
 		if err.Error() == "task not found" {
 			return nil, utils.NewNotFoundError("Task not found")
 		}
@@ -179,24 +315,27 @@ func (s *TaskService) MoveTask(ctx context.Context, uid uuid.UUID, req *models.M
 		return nil, utils.NewInternalError("Failed to get updated task")
 	}
 
-	return &models.TaskResponse{
-		TaskUID:     updatedTask.TaskUID,
-		Title:       updatedTask.Title,
-		Description: updatedTask.Description,
-		Priority:    updatedTask.Priority,
-		Status:      updatedTask.Status,
-		Color:       updatedTask.Color,
-		Position:    updatedTask.Position,
-		IsCompleted: updatedTask.IsCompleted,
-		DueDate:     updatedTask.DueDate,
-		CompletedAt: updatedTask.CompletedAt,
-		CreatedAt:   updatedTask.CreatedAt,
-		UpdatedAt:   updatedTask.UpdatedAt,
-	}, nil
+	return s.taskResponseWithDependencies(ctx, updatedTask)
 }
 
 // PartialUpdateTask updates specific fields of a task
 func (s *TaskService) PartialUpdateTask(ctx context.Context, uid uuid.UUID, updates *models.TaskUpdateRequest) (*models.TaskResponse, error) {
+	// Capture the current state for the change history
+	existingTask, err := s.taskRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "task not found" {
+			return nil, utils.NewNotFoundError("Task not found")
+		}
+		return nil, utils.NewInternalError("Failed to get task")
+	}
+
+	completing := (updates.IsCompleted != nil && *updates.IsCompleted) || (updates.Status != nil && *updates.Status == "completed")
+	if completing && !isTaskCompleted(existingTask) {
+		if err := s.ensureDependenciesComplete(ctx, existingTask); err != nil {
+			return nil, err
+		}
+	}
+
 	// Use repository method for partial update
 	if err := s.taskRepo.PartialUpdate(ctx, uid, *updates); err != nil {
 		if err.Error() == "task not found" {
@@ -211,18 +350,85 @@ func (s *TaskService) PartialUpdateTask(ctx context.Context, uid uuid.UUID, upda
 		return nil, utils.NewInternalError("Failed to get updated task")
 	}
 
-	return &models.TaskResponse{
-		TaskUID:     updatedTask.TaskUID,
-		Title:       updatedTask.Title,
-		Description: updatedTask.Description,
-		Priority:    updatedTask.Priority,
-		Status:      updatedTask.Status,
-		Color:       updatedTask.Color,
-		Position:    updatedTask.Position,
-		IsCompleted: updatedTask.IsCompleted,
-		DueDate:     updatedTask.DueDate,
-		CompletedAt: updatedTask.CompletedAt,
-		CreatedAt:   updatedTask.CreatedAt,
-		UpdatedAt:   updatedTask.UpdatedAt,
+	s.recordTaskChanges(ctx, existingTask, updatedTask)
+	s.spawnNextOccurrence(ctx, existingTask, updatedTask)
+
+	return s.taskResponseWithDependencies(ctx, updatedTask)
+}
+
+// GetOverdueByProject returns overdue task counts and the most overdue tasks for every project
+func (s *TaskService) GetOverdueByProject(ctx context.Context) ([]models.ProjectOverdueResponse, error) {
+	groups, err := s.taskRepo.GetOverdueGroupedByProject(ctx, time.Now().UTC(), overdueTasksPerProject)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get overdue tasks")
+	}
+
+	return groups, nil
+}
+
+// taskDefaults is the set of project-level defaults applied to new tasks
+type taskDefaults struct {
+	priority *string
+	status   *string
+	color    *string
+}
+
+// getTaskDefaults loads the project's task defaults. A project without defaults yields an empty set.
+func (s *TaskService) getTaskDefaults(ctx context.Context, projectID int) (taskDefaults, error) {
+	defaults, err := s.defaultsRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		if err.Error() == "task defaults not found" {
+			return taskDefaults{}, nil
+		}
+		return taskDefaults{}, utils.NewInternalError("Failed to get task defaults")
+	}
+
+	return taskDefaults{
+		priority: defaults.Priority,
+		status:   defaults.Status,
+		color:    defaults.Color,
 	}, nil
 }
+
+// apply fills omitted request values from the project defaults, falling back to the global defaults
+func (d taskDefaults) apply(priority *string, status string, color string) (*string, string, string) {
+	if priority == nil {
+		priority = d.priority
+	}
+
+	if status == "" {
+		status = "todo"
+		if d.status != nil {
+			status = *d.status
+		}
+	}
+
+	if color == "" {
+		color = defaultColor("")
+		if d.color != nil {
+			color = *d.color
+		}
+	}
+
+	return priority, status, color
+}
+
+// newTaskResponse maps a task model to its API representation
+func newTaskResponse(task *models.Task) models.TaskResponse {
+	return models.TaskResponse{
+		TaskUID:     task.TaskUID,
+		Title:       task.Title,
+		Description: task.Description,
+		Priority:    task.Priority,
+		Status:      task.Status,
+		Color:       task.Color,
+		Position:    task.Position,
+		IsCompleted: task.IsCompleted,
+		DueDate:     task.DueDate,
+		CompletedAt: task.CompletedAt,
+		ArchivedAt:  task.ArchivedAt,
+		Recurrence:  task.Recurrence,
+		CreatedAt:   task.CreatedAt,
+		UpdatedAt:   task.UpdatedAt,
+	}
+}
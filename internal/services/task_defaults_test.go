@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"lucid-lists-backend/internal/models"
+)
+
+func setTaskDefaults(t *testing.T, f *taskServiceFixture, list *models.List) {
+	t.Helper()
+
+	err := f.defaults.Upsert(context.Background(), &models.ProjectTaskDefaults{
+		ProjectID: list.ProjectID,
+		Priority:  strPtr("high"),
+		Status:    strPtr("in_progress"),
+		Color:     strPtr("#123456"),
+	})
+	if err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+}
+
+func TestCreateTaskAppliesProjectDefaults(t *testing.T) {
+	f := newTaskServiceFixture()
+	list := f.store.addList(f.store.addProject("Roadmap"), "Doing")
+	setTaskDefaults(t, f, list)
+
+	task, err := f.service.CreateTask(context.Background(), &models.TaskRequest{ListUID: list.ListUID, Title: "Write docs"})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	if task.Priority == nil || *task.Priority != "high" || task.Status != "in_progress" || task.Color != "#123456" {
+		t.Errorf("expected the project defaults, got priority=%v status=%q color=%q", task.Priority, task.Status, task.Color)
+	}
+}
+
+func TestCreateTaskExplicitFieldsWinOverDefaults(t *testing.T) {
+	f := newTaskServiceFixture()
+	list := f.store.addList(f.store.addProject("Roadmap"), "Doing")
+	setTaskDefaults(t, f, list)
+
+	task, err := f.service.CreateTask(context.Background(), &models.TaskRequest{
+		ListUID:  list.ListUID,
+		Title:    "Write docs",
+		Priority: strPtr("low"),
+		Status:   "completed",
+		Color:    "#ABCDEF",
+	})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	if *task.Priority != "low" || task.Status != "completed" || task.Color != "#ABCDEF" {
+		t.Errorf("expected the request values, got priority=%v status=%q color=%q", *task.Priority, task.Status, task.Color)
+	}
+}
+
+func TestCreateTaskWithoutProjectDefaults(t *testing.T) {
+	f := newTaskServiceFixture()
+	list := f.store.addList(f.store.addProject("Roadmap"), "Doing")
+
+	task, err := f.service.CreateTask(context.Background(), &models.TaskRequest{ListUID: list.ListUID, Title: "Write docs"})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	if task.Priority != nil || task.Status != "todo" || task.Color != "#FFFFFF" {
+		t.Errorf("expected the global defaults, got priority=%v status=%q color=%q", task.Priority, task.Status, task.Color)
+	}
+}
+
+func TestCreateTasksBulkAppliesProjectDefaults(t *testing.T) {
+	f := newTaskServiceFixture()
+	list := f.store.addList(f.store.addProject("Roadmap"), "Doing")
+	setTaskDefaults(t, f, list)
+
+	tasks, err := f.service.CreateTasksBulk(context.Background(), list.ListUID, &models.BulkCreateTasksRequest{
+		Tasks: []models.BulkTaskItem{
+			{Title: "Defaults"},
+			{Title: "Explicit", Priority: strPtr("low"), Status: "todo", Color: "#ABCDEF"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateTasksBulk: %v", err)
+	}
+
+	if d := tasks[0]; *d.Priority != "high" || d.Status != "in_progress" || d.Color != "#123456" {
+		t.Errorf("expected the project defaults, got priority=%v status=%q color=%q", *d.Priority, d.Status, d.Color)
+	}
+	if e := tasks[1]; *e.Priority != "low" || e.Status != "todo" || e.Color != "#ABCDEF" {
+		t.Errorf("expected the request values, got priority=%v status=%q color=%q", *e.Priority, e.Status, e.Color)
+	}
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/internal/utils"
+	"lucid-lists-backend/pkg/logger"
+)
+
+// taskHistoryLimit is the number of history entries retained per task
+const taskHistoryLimit = 100
+
+// GetTaskHistory returns the recorded field changes for a task, newest first
+func (s *TaskService) GetTaskHistory(ctx context.Context, uid uuid.UUID) ([]models.TaskHistoryResponse, error) {
+	task, err := s.taskRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "task not found" {
+			return nil, utils.NewNotFoundError("Task not found")
+		}
+		return nil, utils.NewInternalError("Failed to get task")
+	}
+
+	history, err := s.historyRepo.GetByTaskID(ctx, task.ID)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get task history")
+	}
+
+	response := []models.TaskHistoryResponse{}
+	for _, entry := range history {
+		response = append(response, models.TaskHistoryResponse{
+			Field:     entry.Field,
+			OldValue:  entry.OldValue,
+			NewValue:  entry.NewValue,
+			ChangedBy: entry.ChangedBy,
+			ChangedAt: entry.ChangedAt,
+		})
+	}
+
+	return response, nil
+}
+
+// recordTaskChanges stores the differences between two versions of a task.
+// History is best effort: a failure is logged but does not fail the update.
+func (s *TaskService) recordTaskChanges(ctx context.Context, before, after *models.Task) {
+	entries := diffTask(before, after)
+	if len(entries) == 0 {
+		return
+	}
+
+	if err := s.historyRepo.Record(ctx, after.ID, entries, taskHistoryLimit); err != nil {
+		logger.WithComponent("task-service").
+			WithFields(map[string]interface{}{
+				"task_uid": after.TaskUID.String(),
+				"error":    err.Error(),
+			}).
+			Error("Failed to record task history")
+	}
+}
+
+// recordFieldChange stores a single field change for a task, such as one made by a bulk update
+func (s *TaskService) recordFieldChange(ctx context.Context, taskID int, taskUID uuid.UUID, field string, oldValue, newValue *string) {
+	if equalStringPtr(oldValue, newValue) {
+		return
+	}
+
+	entry := models.TaskHistory{Field: field, OldValue: oldValue, NewValue: newValue}
+	if err := s.historyRepo.Record(ctx, taskID, []models.TaskHistory{entry}, taskHistoryLimit); err != nil {
+		logger.WithComponent("task-service").
+			WithFields(map[string]interface{}{
+				"task_uid": taskUID.String(),
+				"error":    err.Error(),
+			}).
+			Error("Failed to record task history")
+	}
+}
+
+// diffTask returns one history entry per user-visible field that changed
+func diffTask(before, after *models.Task) []models.TaskHistory {
+	var entries []models.TaskHistory
+
+	add := func(field string, oldValue, newValue *string) {
+		if equalStringPtr(oldValue, newValue) {
+			return
+		}
+		entries = append(entries, models.TaskHistory{
+			Field:     field,
+			OldValue:  oldValue,
+			NewValue:  newValue,
+			ChangedBy: after.UpdatedBy,
+		})
+	}
+
+	add("title", &before.Title, &after.Title)
+	add("description", before.Description, after.Description)
+	add("priority", before.Priority, after.Priority)
+	add("status", &before.Status, &after.Status)
+	add("color", &before.Color, &after.Color)
+	add("position", intPtrString(before.Position), intPtrString(after.Position))
+	add("is_completed", boolString(before.IsCompleted), boolString(after.IsCompleted))
+	add("due_date", timePtrString(before.DueDate), timePtrString(after.DueDate))
+	add("recurrence", recurrenceString(before.Recurrence), recurrenceString(after.Recurrence))
+
+	return entries
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func intPtrString(i *int) *string {
+	if i == nil {
+		return nil
+	}
+	s := strconv.Itoa(*i)
+	return &s
+}
+
+func boolString(b bool) *string {
+	s := strconv.FormatBool(b)
+	return &s
+}
+
+func timePtrString(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.UTC().Format(time.RFC3339)
+	return &s
+}
+
+func recurrenceString(r *models.Recurrence) *string {
+	if r == nil {
+		return nil
+	}
+	s := r.Freq + "/" + strconv.Itoa(r.Interval)
+	return &s
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+)
+
+func TestPartialUpdateTaskRecordsStatusChange(t *testing.T) {
+	f := newTaskServiceFixture()
+	project := f.store.addProject("Roadmap")
+	task := f.store.addTask(f.store.addList(project, "Doing"), "Write docs")
+
+	_, err := f.service.PartialUpdateTask(context.Background(), task.TaskUID, &models.TaskUpdateRequest{
+		Status: strPtr("in_progress"),
+	})
+	if err != nil {
+		t.Fatalf("PartialUpdateTask: %v", err)
+	}
+
+	history, err := f.service.GetTaskHistory(context.Background(), task.TaskUID)
+	if err != nil {
+		t.Fatalf("GetTaskHistory: %v", err)
+	}
+	if len(history) != 1 {
+		t.Fatalf("expected 1 history entry, got %d: %+v", len(history), history)
+	}
+
+	entry := history[0]
+	if entry.Field != "status" || *entry.OldValue != "todo" || *entry.NewValue != "in_progress" {
+		t.Errorf("unexpected entry: field=%s old=%v new=%v", entry.Field, *entry.OldValue, *entry.NewValue)
+	}
+}
+
+func TestPartialUpdateTaskUnchangedValueRecordsNothing(t *testing.T) {
+	f := newTaskServiceFixture()
+	project := f.store.addProject("Roadmap")
+	task := f.store.addTask(f.store.addList(project, "Doing"), "Write docs")
+
+	_, err := f.service.PartialUpdateTask(context.Background(), task.TaskUID, &models.TaskUpdateRequest{
+		Title:  strPtr("Write docs"),
+		Status: strPtr("todo"),
+	})
+	if err != nil {
+		t.Fatalf("PartialUpdateTask: %v", err)
+	}
+
+	if entries := f.history.entries[task.ID]; len(entries) != 0 {
+		t.Errorf("expected no history entries, got %+v", entries)
+	}
+}
+
+func TestPartialUpdateTaskUnknownTask(t *testing.T) {
+	f := newTaskServiceFixture()
+
+	_, err := f.service.PartialUpdateTask(context.Background(), uuid.New(), &models.TaskUpdateRequest{
+		Status: strPtr("completed"),
+	})
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestGetTaskHistoryUnknownTask(t *testing.T) {
+	f := newTaskServiceFixture()
+
+	_, err := f.service.GetTaskHistory(context.Background(), uuid.New())
+	assertAppError(t, err, http.StatusNotFound)
+}
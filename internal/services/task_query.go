@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/internal/utils"
+)
+
+// TaskQueryParams holds the raw query string values for filtering a project's tasks
+type TaskQueryParams struct {
+	Status      string
+	Priority    string
+	IsCompleted string
+	DueBefore   string
+	DueAfter    string
+	Sort        string
+}
+
+var (
+	taskStatuses   = map[string]bool{"todo": true, "in_progress": true, "completed": true}
+	taskPriorities = map[string]bool{"low": true, "medium": true, "high": true}
+	taskSortKeys   = map[string]bool{"position": true, "due_date": true, "priority": true, "created_at": true}
+)
+
+// QueryProjectTasks returns the project's unarchived tasks matching the given filters and sort order
+func (s *ProjectService) QueryProjectTasks(ctx context.Context, uid uuid.UUID, params TaskQueryParams) ([]models.TaskResponse, error) {
+	filter, err := parseTaskFilter(params)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := s.projectRepo.GetByUID(ctx, uid)
+	if err != nil {
+		if err.Error() == "project not found" {
+			return nil, utils.NewNotFoundError("Project not found")
+		}
+		return nil, utils.NewInternalError("Failed to get project")
+	}
+
+	tasks, err := s.taskRepo.Query(ctx, project.ID, filter)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to query tasks")
+	}
+
+	response := []models.TaskResponse{}
+	for i := range tasks {
+		response = append(response, newTaskResponse(&tasks[i]))
+	}
+
+	return response, nil
+}
+
+// parseTaskFilter validates the raw query values and converts them into a TaskFilter
+func parseTaskFilter(params TaskQueryParams) (models.TaskFilter, error) {
+	var filter models.TaskFilter
+
+	if params.Status != "" {
+		statuses, ok := splitEnumList(params.Status, taskStatuses)
+		if !ok {
+			return filter, utils.NewBadRequestError("status must be a comma-separated list of todo, in_progress, completed")
+		}
+		filter.Statuses = statuses
+	}
+
+	if params.Priority != "" {
+		priorities, ok := splitEnumList(params.Priority, taskPriorities)
+		if !ok {
+			return filter, utils.NewBadRequestError("priority must be a comma-separated list of low, medium, high")
+		}
+		filter.Priorities = priorities
+	}
+
+	if params.IsCompleted != "" {
+		isCompleted, err := strconv.ParseBool(params.IsCompleted)
+		if err != nil {
+			return filter, utils.NewBadRequestError("is_completed must be true or false")
+		}
+		filter.IsCompleted = &isCompleted
+	}
+
+	if params.DueBefore != "" {
+		dueBefore, err := parseTaskQueryTime(params.DueBefore)
+		if err != nil {
+			return filter, utils.NewBadRequestError("due_before must be a date (YYYY-MM-DD) or RFC 3339 timestamp")
+		}
+		filter.DueBefore = &dueBefore
+	}
+
+	if params.DueAfter != "" {
+		dueAfter, err := parseTaskQueryTime(params.DueAfter)
+		if err != nil {
+			return filter, utils.NewBadRequestError("due_after must be a date (YYYY-MM-DD) or RFC 3339 timestamp")
+		}
+		filter.DueAfter = &dueAfter
+	}
+
+	if params.Sort != "" {
+		if !taskSortKeys[params.Sort] {
+			return filter, utils.NewBadRequestError("sort must be one of position, due_date, priority, created_at")
+		}
+		filter.Sort = params.Sort
+	}
+
+	return filter, nil
+}
+
+// splitEnumList splits a comma-separated value, dropping duplicates, and reports false if any entry is not allowed
+func splitEnumList(value string, allowed map[string]bool) ([]string, bool) {
+	seen := make(map[string]bool)
+	var values []string
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if !allowed[part] {
+			return nil, false
+		}
+		if !seen[part] {
+			seen[part] = true
+			values = append(values, part)
+		}
+	}
+	return values, true
+}
+
+// parseTaskQueryTime accepts either an RFC 3339 timestamp or a bare date, which is read as UTC midnight
+func parseTaskQueryTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(utils.DateLayout, value)
+}
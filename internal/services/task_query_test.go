@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+)
+
+func TestQueryProjectTasksBuildsFilter(t *testing.T) {
+	f := newProjectServiceFixture()
+	project := f.store.addProject("Launch")
+	f.tasks.queryTasks = []models.Task{{TaskUID: uuid.New(), Title: "Draft"}, {TaskUID: uuid.New(), Title: "Review"}}
+
+	tasks, err := f.service.QueryProjectTasks(context.Background(), project.ProjectUID, TaskQueryParams{
+		Status:      "todo, in_progress,todo",
+		Priority:    "high,low",
+		IsCompleted: "false",
+		DueBefore:   "2026-03-10",
+		Sort:        "due_date",
+	})
+	if err != nil {
+		t.Fatalf("QueryProjectTasks: %v", err)
+	}
+
+	isCompleted := false
+	dueBefore := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	want := []models.TaskFilter{{
+		Statuses:    []string{"todo", "in_progress"},
+		Priorities:  []string{"high", "low"},
+		IsCompleted: &isCompleted,
+		DueBefore:   &dueBefore,
+		Sort:        "due_date",
+	}}
+	if !reflect.DeepEqual(f.tasks.queries, want) {
+		t.Errorf("expected filter %+v, got %+v", want[0], f.tasks.queries)
+	}
+
+	var titles []string
+	for _, task := range tasks {
+		titles = append(titles, task.Title)
+	}
+	assertTitles(t, titles, "Draft", "Review")
+}
+
+func TestQueryProjectTasksInvalidParams(t *testing.T) {
+	f := newProjectServiceFixture()
+	project := f.store.addProject("Launch")
+
+	tests := []struct {
+		name   string
+		params TaskQueryParams
+	}{
+		{"unknown status", TaskQueryParams{Status: "todo,blocked"}},
+		{"empty status entry", TaskQueryParams{Status: "todo,"}},
+		{"unknown priority", TaskQueryParams{Priority: "urgent"}},
+		{"bad is_completed", TaskQueryParams{IsCompleted: "maybe"}},
+		{"bad due_before", TaskQueryParams{DueBefore: "next week"}},
+		{"unknown sort", TaskQueryParams{Sort: "title"}},
+	}
+
+	for _, tt := range tests {
+		_, err := f.service.QueryProjectTasks(context.Background(), project.ProjectUID, tt.params)
+		t.Run(tt.name, func(t *testing.T) {
+			assertAppError(t, err, http.StatusBadRequest)
+		})
+	}
+}
+
+func TestQueryProjectTasksUnknownProject(t *testing.T) {
+	f := newProjectServiceFixture()
+
+	_, err := f.service.QueryProjectTasks(context.Background(), uuid.New(), TaskQueryParams{Status: "todo"})
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestQueryProjectTasksRejectsBadParamsBeforeQuerying(t *testing.T) {
+	f := newProjectServiceFixture()
+	project := f.store.addProject("Launch")
+
+	_, err := f.service.QueryProjectTasks(context.Background(), project.ProjectUID, TaskQueryParams{Sort: "title"})
+	assertAppError(t, err, http.StatusBadRequest)
+
+	if len(f.tasks.queries) != 0 {
+		t.Errorf("expected no query, got %+v", f.tasks.queries)
+	}
+}
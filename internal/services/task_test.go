@@ -0,0 +1,311 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/internal/utils"
+)
+
+func completedTask(f *taskServiceFixture) (*models.List, *models.Task) {
+	project := f.store.addProject("Roadmap")
+	list := f.store.addList(project, "Done")
+	task := f.store.addTask(list, "Ship it")
+
+	completedAt := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	task.Status = "completed"
+	task.IsCompleted = true
+	task.CompletedAt = &completedAt
+	return list, task
+}
+
+func TestUpdateTaskTitleOnlyKeepsCompletion(t *testing.T) {
+	f := newTaskServiceFixture()
+	list, task := completedTask(f)
+	completedAt := *task.CompletedAt
+
+	updated, err := f.service.UpdateTask(context.Background(), task.TaskUID, &models.TaskRequest{
+		ListUID: list.ListUID,
+		Title:   "Ship it today",
+	})
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	if updated.Title != "Ship it today" {
+		t.Errorf("expected the new title, got %q", updated.Title)
+	}
+	if !updated.IsCompleted || updated.Status != "completed" {
+		t.Errorf("expected the task to stay completed, got is_completed=%v status=%s", updated.IsCompleted, updated.Status)
+	}
+	if updated.CompletedAt == nil || !updated.CompletedAt.Equal(completedAt) {
+		t.Errorf("expected completed_at %v to be kept, got %v", completedAt, updated.CompletedAt)
+	}
+}
+
+func TestUpdateTaskReopenClearsCompletedAt(t *testing.T) {
+	f := newTaskServiceFixture()
+	list, task := completedTask(f)
+
+	updated, err := f.service.UpdateTask(context.Background(), task.TaskUID, &models.TaskRequest{
+		ListUID:     list.ListUID,
+		Title:       "Ship it",
+		Status:      "todo",
+		IsCompleted: boolPtr(false),
+	})
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	if updated.IsCompleted || updated.CompletedAt != nil {
+		t.Errorf("expected completion to be cleared, got is_completed=%v completed_at=%v", updated.IsCompleted, updated.CompletedAt)
+	}
+}
+
+func TestUpdateTaskUnknownTask(t *testing.T) {
+	f := newTaskServiceFixture()
+
+	_, err := f.service.UpdateTask(context.Background(), uuid.New(), &models.TaskRequest{ListUID: uuid.New(), Title: "Ghost"})
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestGetOverdueByProjectQueriesUTCNow(t *testing.T) {
+	f := newTaskServiceFixture()
+	f.tasks.overdueGroups = []models.ProjectOverdueResponse{{ProjectUID: uuid.New(), ProjectName: "Busy", OverdueCount: 1}}
+
+	before := time.Now()
+	groups, err := f.service.GetOverdueByProject(context.Background())
+	if err != nil {
+		t.Fatalf("GetOverdueByProject: %v", err)
+	}
+
+	if len(f.tasks.overdueNow) != 1 {
+		t.Fatalf("expected one query, got %d", len(f.tasks.overdueNow))
+	}
+	now := f.tasks.overdueNow[0]
+	if now.Location() != time.UTC || now.Before(before.Add(-time.Second)) || now.After(time.Now()) {
+		t.Errorf("expected the current time in UTC, got %v", now)
+	}
+	if f.tasks.overdueLimit != overdueTasksPerProject {
+		t.Errorf("expected %d tasks per project, got %d", overdueTasksPerProject, f.tasks.overdueLimit)
+	}
+	if len(groups) != 1 || groups[0].ProjectName != "Busy" {
+		t.Errorf("expected the repository groups, got %+v", groups)
+	}
+}
+
+func TestMoveTaskPassesTargetListAndPosition(t *testing.T) {
+	f := newTaskServiceFixture()
+	project := f.store.addProject("Roadmap")
+	todo := f.store.addList(project, "Todo")
+	done := f.store.addList(project, "Done")
+	task := f.store.addTask(todo, "A")
+
+	moved, err := f.service.MoveTask(context.Background(), task.TaskUID, &models.MoveTaskRequest{ListUID: done.ListUID, Position: intPtr(2)})
+	if err != nil {
+		t.Fatalf("MoveTask: %v", err)
+	}
+
+	if len(f.tasks.moves) != 1 {
+		t.Fatalf("expected one move, got %d", len(f.tasks.moves))
+	}
+	move := f.tasks.moves[0]
+	if move.uid != task.TaskUID || move.listID != done.ID || move.position == nil || *move.position != 2 {
+		t.Errorf("unexpected move %+v", move)
+	}
+	if moved.Position == nil || *moved.Position != 2 {
+		t.Errorf("expected the response to come from the moved task, got position %v", moved.Position)
+	}
+}
+
+func TestMoveTaskNotFound(t *testing.T) {
+	f := newTaskServiceFixture()
+	list := f.store.addList(f.store.addProject("Roadmap"), "Todo")
+	task := f.store.addTask(list, "A")
+
+	_, err := f.service.MoveTask(context.Background(), uuid.New(), &models.MoveTaskRequest{ListUID: list.ListUID})
+	assertAppError(t, err, http.StatusNotFound)
+
+	_, err = f.service.MoveTask(context.Background(), task.TaskUID, &models.MoveTaskRequest{ListUID: uuid.New()})
+	assertAppError(t, err, http.StatusNotFound)
+}
+
+func TestMoveTaskPositionsAreOneBased(t *testing.T) {
+	listUID := uuid.New()
+
+	if err := utils.ValidateStruct(&models.MoveTaskRequest{ListUID: listUID, Position: intPtr(0)}); err == nil {
+		t.Error("expected MoveTaskRequest to reject position 0")
+	}
+	if err := utils.ValidateStruct(&models.MoveTaskRequest{ListUID: listUID, Position: intPtr(1)}); err != nil {
+		t.Errorf("expected MoveTaskRequest to accept position 1: %v", err)
+	}
+}
+
+func TestBulkPriorityRequestValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		req  models.BulkPriorityRequest
+	}{
+		{"missing task_uids", models.BulkPriorityRequest{Priority: "high"}},
+		{"empty task_uids", models.BulkPriorityRequest{TaskUIDs: []uuid.UUID{}, Priority: "high"}},
+		{"missing priority", models.BulkPriorityRequest{TaskUIDs: []uuid.UUID{uuid.New()}}},
+		{"unknown priority", models.BulkPriorityRequest{TaskUIDs: []uuid.UUID{uuid.New()}, Priority: "urgent"}},
+	}
+
+	for _, tt := range tests {
+		if err := utils.ValidateStruct(&tt.req); err == nil {
+			t.Errorf("%s: expected a validation error", tt.name)
+		}
+	}
+}
+
+func TestBulkUpdatePriority(t *testing.T) {
+	f := newTaskServiceFixture()
+	first := models.TaskFieldChange{TaskID: 1, TaskUID: uuid.New()}
+	second := models.TaskFieldChange{TaskID: 2, TaskUID: uuid.New(), OldValue: strPtr("low")}
+	unknown := uuid.New()
+	f.tasks.bulkChanges = []models.TaskFieldChange{first, second}
+
+	result, err := f.service.BulkUpdatePriority(context.Background(), &models.BulkPriorityRequest{
+		TaskUIDs: []uuid.UUID{first.TaskUID, second.TaskUID, first.TaskUID, unknown, unknown},
+		Priority: "high",
+	})
+	if err != nil {
+		t.Fatalf("BulkUpdatePriority: %v", err)
+	}
+
+	if len(f.tasks.bulkUpdates) != 1 {
+		t.Fatalf("expected one bulk update, got %d", len(f.tasks.bulkUpdates))
+	}
+	update := f.tasks.bulkUpdates[0]
+	if !reflect.DeepEqual(update.uids, []uuid.UUID{first.TaskUID, second.TaskUID, unknown}) {
+		t.Errorf("expected each uid once in request order, got %v", update.uids)
+	}
+	if update.field != "priority" || update.value != "high" {
+		t.Errorf("expected priority=high, got %s=%s", update.field, update.value)
+	}
+
+	if result.Updated != 2 {
+		t.Errorf("expected 2 updated tasks, got %d", result.Updated)
+	}
+	if len(result.Failed) != 1 || result.Failed[0] != unknown {
+		t.Errorf("expected only the unknown uid to fail once, got %v", result.Failed)
+	}
+
+	entries := f.history.entries[second.TaskID]
+	if len(entries) != 1 || entries[0].Field != "priority" || *entries[0].OldValue != "low" || *entries[0].NewValue != "high" {
+		t.Errorf("expected one priority change from low to high, got %+v", entries)
+	}
+	entries = f.history.entries[first.TaskID]
+	if len(entries) != 1 || entries[0].OldValue != nil || *entries[0].NewValue != "high" {
+		t.Errorf("expected one priority change from none to high, got %+v", entries)
+	}
+}
+
+func TestBulkColorRequestValidation(t *testing.T) {
+	uids := []uuid.UUID{uuid.New()}
+	for _, color := range []string{"", "red", "#FF00", "#GGGGGG", "FF0000F"} {
+		err := utils.ValidateStruct(&models.BulkColorRequest{TaskUIDs: uids, Color: color})
+		if err == nil {
+			t.Errorf("expected color %q to be rejected", color)
+		}
+	}
+
+	if err := utils.ValidateStruct(&models.BulkColorRequest{Color: "#FF0000"}); err == nil {
+		t.Error("expected missing task_uids to be rejected")
+	}
+	if err := utils.ValidateStruct(&models.BulkColorRequest{TaskUIDs: uids, Color: "#ff00aa"}); err != nil {
+		t.Errorf("expected a lower case hex color to be accepted, got %v", err)
+	}
+}
+
+func TestBulkUpdateColor(t *testing.T) {
+	f := newTaskServiceFixture()
+	changed := models.TaskFieldChange{TaskID: 1, TaskUID: uuid.New(), OldValue: strPtr("#FFFFFF")}
+	unchanged := models.TaskFieldChange{TaskID: 2, TaskUID: uuid.New(), OldValue: strPtr("#FF0000")}
+	deleted := uuid.New()
+	f.tasks.bulkChanges = []models.TaskFieldChange{changed, unchanged}
+
+	result, err := f.service.BulkUpdateColor(context.Background(), &models.BulkColorRequest{
+		TaskUIDs: []uuid.UUID{changed.TaskUID, unchanged.TaskUID, deleted},
+		Color:    "#FF0000",
+	})
+	if err != nil {
+		t.Fatalf("BulkUpdateColor: %v", err)
+	}
+
+	update := f.tasks.bulkUpdates[0]
+	if update.field != "color" || update.value != "#FF0000" {
+		t.Errorf("expected color=#FF0000, got %s=%s", update.field, update.value)
+	}
+	if result.Updated != 2 {
+		t.Errorf("expected 2 updated tasks, got %d", result.Updated)
+	}
+	if len(result.Failed) != 1 || result.Failed[0] != deleted {
+		t.Errorf("expected the deleted task to fail, got %v", result.Failed)
+	}
+
+	entries := f.history.entries[changed.TaskID]
+	if len(entries) != 1 || entries[0].Field != "color" || *entries[0].OldValue != "#FFFFFF" || *entries[0].NewValue != "#FF0000" {
+		t.Errorf("expected one color change from #FFFFFF to #FF0000, got %+v", entries)
+	}
+	if entries := f.history.entries[unchanged.TaskID]; len(entries) != 0 {
+		t.Errorf("expected no history for a task that already had the color, got %+v", entries)
+	}
+}
+
+func TestBulkUpdateColorRepositoryError(t *testing.T) {
+	f := newTaskServiceFixture()
+	f.tasks.bulkErr = fmt.Errorf("connection reset")
+
+	_, err := f.service.BulkUpdateColor(context.Background(), &models.BulkColorRequest{
+		TaskUIDs: []uuid.UUID{uuid.New()},
+		Color:    "#FF0000",
+	})
+	assertAppError(t, err, http.StatusInternalServerError)
+}
+
+func bulkTaskItems(n int) []models.BulkTaskItem {
+	items := make([]models.BulkTaskItem, n)
+	for i := range items {
+		items[i] = models.BulkTaskItem{Title: fmt.Sprintf("Task %d", i+1)}
+	}
+	return items
+}
+
+func TestCreateTasksBulkAcceptsTheLimit(t *testing.T) {
+	f := newTaskServiceFixture()
+	list := f.store.addList(f.store.addProject("Roadmap"), "Backlog")
+	f.store.addTask(list, "Existing")
+
+	tasks, err := f.service.CreateTasksBulk(context.Background(), list.ListUID, &models.BulkCreateTasksRequest{Tasks: bulkTaskItems(maxBulkTasks)})
+	if err != nil {
+		t.Fatalf("CreateTasksBulk: %v", err)
+	}
+
+	if len(tasks) != maxBulkTasks {
+		t.Fatalf("expected %d tasks, got %d", maxBulkTasks, len(tasks))
+	}
+	if first, last := tasks[0], tasks[len(tasks)-1]; *first.Position != 2 || *last.Position != maxBulkTasks+1 {
+		t.Errorf("expected positions 2 to %d, got %d to %d", maxBulkTasks+1, *first.Position, *last.Position)
+	}
+}
+
+func TestCreateTasksBulkRejectsMoreThanTheLimit(t *testing.T) {
+	f := newTaskServiceFixture()
+	list := f.store.addList(f.store.addProject("Roadmap"), "Backlog")
+
+	_, err := f.service.CreateTasksBulk(context.Background(), list.ListUID, &models.BulkCreateTasksRequest{Tasks: bulkTaskItems(maxBulkTasks + 1)})
+	assertAppError(t, err, http.StatusBadRequest)
+
+	if n := len(f.store.tasksInList(list.ID)); n != 0 {
+		t.Errorf("expected no tasks to be created, got %d", n)
+	}
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"lucid-lists-backend/internal/models"
+	"lucid-lists-backend/internal/repositories"
+	"lucid-lists-backend/internal/utils"
+)
+
+type TemplateService struct {
+	templateRepo repositories.TemplateRepository
+}
+
+func NewTemplateService(templateRepo repositories.TemplateRepository) *TemplateService {
+	return &TemplateService{
+		templateRepo: templateRepo,
+	}
+}
+
+// GetTemplates returns all available project templates with their list layout
+func (s *TemplateService) GetTemplates(ctx context.Context) ([]models.TemplateResponse, error) {
+	templates, err := s.templateRepo.GetAll(ctx)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to retrieve templates")
+	}
+
+	response := []models.TemplateResponse{}
+	for _, template := range templates {
+		body, err := parseTemplateBody(template.Body)
+		if err != nil {
+			return nil, utils.NewInternalError("Failed to read template " + template.Name)
+		}
+
+		response = append(response, models.TemplateResponse{
+			TemplateUID: template.TemplateUID,
+			Name:        template.Name,
+			Description: template.Description,
+			Lists:       body.Lists,
+		})
+	}
+
+	return response, nil
+}
+
+func parseTemplateBody(raw []byte) (*models.TemplateBody, error) {
+	var body models.TemplateBody
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, fmt.Errorf("invalid template body: %w", err)
+	}
+	if err := utils.ValidateStruct(&body); err != nil {
+		return nil, fmt.Errorf("invalid template body: %w", err)
+	}
+	if body.Lists == nil {
+		body.Lists = []models.TemplateList{}
+	}
+	return &body, nil
+}
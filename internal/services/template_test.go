@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"lucid-lists-backend/internal/models"
+)
+
+const scrumTemplateBody = `{"lists": [
+	{"name": "Backlog", "color": "#E2E8F0", "tasks": [
+		{"title": "Write user stories", "priority": "medium"},
+		{"title": "Estimate backlog items"}
+	]},
+	{"name": "Done", "tasks": []}
+]}`
+
+func newTemplate(name, body string) models.ProjectTemplate {
+	return models.ProjectTemplate{TemplateUID: uuid.New(), Name: name, Body: []byte(body), IsActive: true}
+}
+
+func TestGetTemplates(t *testing.T) {
+	repo := &fakeTemplateRepo{templates: []models.ProjectTemplate{newTemplate("Scrum board", scrumTemplateBody)}}
+
+	templates, err := NewTemplateService(repo).GetTemplates(context.Background())
+	if err != nil {
+		t.Fatalf("GetTemplates: %v", err)
+	}
+	if len(templates) != 1 || len(templates[0].Lists) != 2 || len(templates[0].Lists[0].Tasks) != 2 {
+		t.Fatalf("unexpected templates: %+v", templates)
+	}
+}
+
+func TestGetTemplatesRejectsInvalidPriority(t *testing.T) {
+	body := `{"lists": [{"name": "Backlog", "tasks": [{"title": "Triage", "priority": "urgent"}]}]}`
+	repo := &fakeTemplateRepo{templates: []models.ProjectTemplate{newTemplate("Broken", body)}}
+
+	_, err := NewTemplateService(repo).GetTemplates(context.Background())
+	assertAppError(t, err, http.StatusInternalServerError)
+}
+
+func TestParseTemplateBodyValidatesTasks(t *testing.T) {
+	invalid := map[string]string{
+		"priority":   `{"lists": [{"name": "A", "tasks": [{"title": "T", "priority": "urgent"}]}]}`,
+		"task title": `{"lists": [{"name": "A", "tasks": [{"title": ""}]}]}`,
+		"task color": `{"lists": [{"name": "A", "tasks": [{"title": "T", "color": "red"}]}]}`,
+		"list name":  `{"lists": [{"name": "", "tasks": []}]}`,
+	}
+	for name, body := range invalid {
+		if _, err := parseTemplateBody([]byte(body)); err == nil {
+			t.Errorf("expected an invalid %s to be rejected", name)
+		}
+	}
+
+	for _, priority := range []string{"low", "medium", "high"} {
+		body := `{"lists": [{"name": "A", "tasks": [{"title": "T", "priority": "` + priority + `"}]}]}`
+		if _, err := parseTemplateBody([]byte(body)); err != nil {
+			t.Errorf("expected priority %q to be accepted: %v", priority, err)
+		}
+	}
+}
+
+func TestCreateFromTemplate(t *testing.T) {
+	f := newProjectServiceFixture()
+	template := newTemplate("Scrum board", scrumTemplateBody)
+	f.templates.templates = append(f.templates.templates, template)
+
+	project, err := f.service.CreateFromTemplate(context.Background(), &models.ProjectFromTemplateRequest{
+		TemplateUID: template.TemplateUID,
+		Name:        "Sprint 12",
+	})
+	if err != nil {
+		t.Fatalf("CreateFromTemplate: %v", err)
+	}
+
+	if project.Name != "Sprint 12" || len(project.Lists) != 2 {
+		t.Fatalf("unexpected project: %+v", project)
+	}
+	backlog := project.Lists[0]
+	if backlog.Name != "Backlog" || len(backlog.Tasks) != 2 {
+		t.Fatalf("unexpected first list: %+v", backlog)
+	}
+	if *backlog.Tasks[0].Priority != "medium" || backlog.Tasks[1].Priority != nil {
+		t.Errorf("expected template priorities to be kept, got %v and %v", backlog.Tasks[0].Priority, backlog.Tasks[1].Priority)
+	}
+	for _, task := range backlog.Tasks {
+		if task.Status != "todo" || task.Color != "#FFFFFF" || task.IsCompleted {
+			t.Errorf("expected task %q to get the CreateTask defaults, got %+v", task.Title, task)
+		}
+	}
+	if project.Lists[1].Color != "#FFFFFF" {
+		t.Errorf("expected the default list color, got %s", project.Lists[1].Color)
+	}
+}
+
+func TestCreateFromTemplateInvalidTemplate(t *testing.T) {
+	f := newProjectServiceFixture()
+	template := newTemplate("Broken", `{"lists": [{"name": "A", "tasks": [{"title": "T", "priority": "urgent"}]}]}`)
+	f.templates.templates = append(f.templates.templates, template)
+
+	_, err := f.service.CreateFromTemplate(context.Background(), &models.ProjectFromTemplateRequest{
+		TemplateUID: template.TemplateUID,
+		Name:        "Sprint 12",
+	})
+	assertAppError(t, err, http.StatusInternalServerError)
+
+	if len(f.store.projects) != 0 {
+		t.Errorf("expected no project to be created, got %d", len(f.store.projects))
+	}
+}
+
+func TestCreateFromTemplateUnknownTemplate(t *testing.T) {
+	f := newProjectServiceFixture()
+
+	_, err := f.service.CreateFromTemplate(context.Background(), &models.ProjectFromTemplateRequest{
+		TemplateUID: uuid.New(),
+		Name:        "Sprint 12",
+	})
+	assertAppError(t, err, http.StatusNotFound)
+}
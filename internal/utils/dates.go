@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+)
+
+// DateLayout is the calendar date format used in query parameters and responses
+const DateLayout = "2006-01-02"
+
+// ParseTimezone loads an IANA timezone name, defaulting to UTC when empty
+func ParseTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, NewBadRequestError("Invalid timezone: " + name)
+	}
+
+	return loc, nil
+}
+
+// ParseDateRange parses inclusive from/to dates in loc and returns the half-open
+// interval [start, end) covering both days. The span may not exceed maxDays.
+func ParseDateRange(from, to string, loc *time.Location, maxDays int) (time.Time, time.Time, error) {
+	if from == "" || to == "" {
+		return time.Time{}, time.Time{}, NewBadRequestError("from and to are required")
+	}
+
+	start, err := time.ParseInLocation(DateLayout, from, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, NewBadRequestError("from must be a date in YYYY-MM-DD format")
+	}
+
+	last, err := time.ParseInLocation(DateLayout, to, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, NewBadRequestError("to must be a date in YYYY-MM-DD format")
+	}
+
+	if last.Before(start) {
+		return time.Time{}, time.Time{}, NewBadRequestError("to must not be before from")
+	}
+
+	end := last.AddDate(0, 0, 1)
+	if end.After(start.AddDate(0, 0, maxDays)) {
+		return time.Time{}, time.Time{}, NewBadRequestError(fmt.Sprintf("date range may span at most %d days", maxDays))
+	}
+
+	return start, end, nil
+}
@@ -0,0 +1,10 @@
+-- Daily task completion snapshots per project, written by the progress snapshot job
+CREATE TABLE IF NOT EXISTS project_progress_snapshot (
+    id              SERIAL PRIMARY KEY,
+    project_id      INTEGER NOT NULL REFERENCES project(id),
+    snapshot_date   DATE NOT NULL,
+    total_tasks     INTEGER NOT NULL DEFAULT 0,
+    completed_tasks INTEGER NOT NULL DEFAULT 0,
+    created_at      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (project_id, snapshot_date)
+);
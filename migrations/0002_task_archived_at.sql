@@ -0,0 +1,2 @@
+-- Completed tasks can be archived to hide them from the default board view
+ALTER TABLE task ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP NULL;
@@ -0,0 +1,12 @@
+-- Field-level audit trail of task updates
+CREATE TABLE IF NOT EXISTS task_history (
+    id          SERIAL PRIMARY KEY,
+    task_id     INTEGER NOT NULL REFERENCES task(id),
+    field       VARCHAR(50) NOT NULL,
+    old_value   TEXT NULL,
+    new_value   TEXT NULL,
+    changed_by  UUID NULL,
+    changed_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_history_task_id ON task_history (task_id, changed_at DESC);
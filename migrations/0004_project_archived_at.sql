@@ -0,0 +1,2 @@
+-- Archived projects are hidden from the default project list but remain readable
+ALTER TABLE project ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP NULL;
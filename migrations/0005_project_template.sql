@@ -0,0 +1,52 @@
+-- Starter templates describing lists and seed tasks for new projects
+CREATE TABLE IF NOT EXISTS project_template (
+    id           SERIAL PRIMARY KEY,
+    template_uid UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    name         VARCHAR(255) NOT NULL,
+    description  TEXT NULL,
+    body         JSONB NOT NULL,
+    created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    is_active    BOOLEAN NOT NULL DEFAULT true
+);
+
+-- Earlier runs of this migration inserted the seed templates again each time; keep the oldest copy
+DELETE FROM project_template a
+USING project_template b
+WHERE a.name = b.name AND a.id > b.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_project_template_name ON project_template (name);
+
+INSERT INTO project_template (name, description, body) VALUES
+(
+    'Scrum board',
+    'Backlog, sprint, and review columns for iterative development',
+    '{"lists": [
+        {"name": "Backlog", "color": "#E2E8F0", "tasks": [
+            {"title": "Write user stories", "priority": "medium"},
+            {"title": "Estimate backlog items", "priority": "low"}
+        ]},
+        {"name": "Sprint", "color": "#BFDBFE", "tasks": [
+            {"title": "Sprint planning", "priority": "high"}
+        ]},
+        {"name": "In Progress", "color": "#FDE68A", "tasks": []},
+        {"name": "Review", "color": "#DDD6FE", "tasks": [
+            {"title": "Sprint review", "priority": "medium"},
+            {"title": "Retrospective", "priority": "medium"}
+        ]},
+        {"name": "Done", "color": "#BBF7D0", "tasks": []}
+    ]}'
+),
+(
+    'Content calendar',
+    'Plan, write, and publish content',
+    '{"lists": [
+        {"name": "Ideas", "color": "#E2E8F0", "tasks": [
+            {"title": "Brainstorm topics for next month", "priority": "medium"}
+        ]},
+        {"name": "Drafting", "color": "#FDE68A", "tasks": []},
+        {"name": "Editing", "color": "#DDD6FE", "tasks": []},
+        {"name": "Scheduled", "color": "#BFDBFE", "tasks": []},
+        {"name": "Published", "color": "#BBF7D0", "tasks": []}
+    ]}'
+)
+ON CONFLICT (name) DO NOTHING;
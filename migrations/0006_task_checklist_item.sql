@@ -0,0 +1,14 @@
+-- Lightweight checklist items under a task
+CREATE TABLE IF NOT EXISTS task_checklist_item (
+    id            SERIAL PRIMARY KEY,
+    item_uid      UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    task_id       INTEGER NOT NULL REFERENCES task(id),
+    title         VARCHAR(255) NOT NULL,
+    is_completed  BOOLEAN NOT NULL DEFAULT false,
+    position      INTEGER NOT NULL DEFAULT 0,
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMP NULL,
+    is_active     BOOLEAN NOT NULL DEFAULT true
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_checklist_item_task_id ON task_checklist_item (task_id, position);
@@ -0,0 +1,8 @@
+-- Per-project defaults applied to new tasks that omit these fields
+CREATE TABLE IF NOT EXISTS project_task_defaults (
+    project_id  INTEGER PRIMARY KEY REFERENCES project(id),
+    priority    VARCHAR(20) NULL,
+    status      VARCHAR(20) NULL,
+    color       VARCHAR(7) NULL,
+    updated_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,2 @@
+-- Recurrence rule for tasks that regenerate on completion, e.g. {"freq": "weekly", "interval": 1}
+ALTER TABLE task ADD COLUMN IF NOT EXISTS recurrence JSONB NULL;
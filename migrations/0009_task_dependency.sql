@@ -0,0 +1,10 @@
+-- A task cannot be completed until every task it depends on is complete
+CREATE TABLE IF NOT EXISTS task_dependency (
+    task_id             INTEGER NOT NULL REFERENCES task(id),
+    depends_on_task_id  INTEGER NOT NULL REFERENCES task(id),
+    created_at          TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (task_id, depends_on_task_id),
+    CHECK (task_id <> depends_on_task_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_dependency_depends_on ON task_dependency (depends_on_task_id);
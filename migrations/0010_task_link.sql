@@ -0,0 +1,13 @@
+-- External URLs attached to a task
+CREATE TABLE IF NOT EXISTS task_link (
+    id          SERIAL PRIMARY KEY,
+    link_uid    UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    task_id     INTEGER NOT NULL REFERENCES task(id),
+    url         VARCHAR(2048) NOT NULL,
+    title       VARCHAR(255) NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by  UUID NULL,
+    is_active   BOOLEAN NOT NULL DEFAULT true
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_link_task_id ON task_link (task_id);
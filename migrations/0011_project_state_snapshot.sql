@@ -0,0 +1,12 @@
+-- Point-in-time copies of a project's lists and tasks, used to undo bulk changes
+CREATE TABLE IF NOT EXISTS project_state_snapshot (
+    id            SERIAL PRIMARY KEY,
+    snapshot_uid  UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    project_id    INTEGER NOT NULL REFERENCES project(id),
+    state         JSONB NOT NULL,
+    list_count    INTEGER NOT NULL DEFAULT 0,
+    task_count    INTEGER NOT NULL DEFAULT 0,
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_state_snapshot_project_id ON project_state_snapshot (project_id, created_at DESC);